@@ -36,6 +36,11 @@ type List struct {
 	level int
 	// outputMirror stores an io.Writer where the "Render" functions would write
 	outputMirror io.Writer
+	// renderHTML is true when rendering in HTML (set by initForRender)
+	renderHTML bool
+	// renderTarget is the io.Writer to render to in chunks (set only during
+	// calls to RenderTo and its variants)
+	renderTarget *renderTarget
 	// style contains all the strings used to draw the List, and more
 	style *Style
 }
@@ -125,9 +130,10 @@ func (l *List) UnIndentAll() {
 	l.level = 0
 }
 
-func (l *List) initForRender() {
+func (l *List) initForRender(renderHTML bool) {
 	// pick a default style
 	l.Style()
+	l.renderHTML = renderHTML
 
 	// pre-compute the whitespace stand-in for the vertical connector used
 	// in every line rendered for nested or multi-line items
@@ -165,7 +171,14 @@ func (l *List) hasMoreItemsInLevel(levelIdx int, fromItemIdx int) bool {
 }
 
 func (l *List) render(out *strings.Builder) string {
-	outStr := out.String()
+	outStr := l.finalizeOutput(out.String())
+	if l.renderTarget != nil {
+		l.renderTarget.write(outStr)
+		if l.renderTarget.written > 0 {
+			l.renderTarget.write("\n")
+		}
+		return ""
+	}
 	if l.outputMirror != nil && len(outStr) > 0 {
 		_, _ = l.outputMirror.Write([]byte(outStr))
 		_, _ = l.outputMirror.Write([]byte("\n"))
@@ -173,6 +186,15 @@ func (l *List) render(out *strings.Builder) string {
 	return outStr
 }
 
+// finalizeOutput converts the RGB colors in the rendered output to the
+// ColorDepth supported by the terminal unless it is HTML.
+func (l *List) finalizeOutput(outStr string) string {
+	if l.renderHTML {
+		return outStr
+	}
+	return text.DegradeRGBColors(outStr)
+}
+
 // renderHint has hints for the Render*() logic
 type renderHint struct {
 	isTopItem    bool
@@ -15,11 +15,14 @@ import (
 // | * The Dark Tower
 // |   * The Gunslinger
 func (l *List) Render() string {
-	l.initForRender()
+	l.initForRender(false)
 
 	var out strings.Builder
-	out.Grow(l.approxSize)
+	out.Grow(l.estimatedRenderLength())
 	for idx, item := range l.items {
+		if !l.renderToFlush(&out) {
+			break
+		}
 		hint := renderHint{
 			isTopItem:    idx == 0,
 			isFirstItem:  idx == 0 || item.Level > l.items[idx-1].Level,
@@ -26,11 +26,11 @@ import (
 //	  </ul>
 //	</ul>
 func (l *List) RenderHTML() string {
-	l.initForRender()
+	l.initForRender(true)
 
 	var out strings.Builder
 	if len(l.items) > 0 {
-		out.Grow(l.approxSize)
+		out.Grow(l.estimatedRenderLength())
 		l.htmlRenderRecursively(&out, 0, l.items[0])
 	}
 	return l.render(&out)
@@ -49,6 +49,9 @@ func (l *List) htmlRenderRecursively(out *strings.Builder, idx int, item *listIt
 	out.WriteString("\">\n")
 	var numItemsRendered int
 	for itemIdx := idx; itemIdx < len(l.items); itemIdx++ {
+		if !l.renderToFlush(out) {
+			break
+		}
 		if l.items[itemIdx].Level == item.Level {
 			out.WriteString(linePrefix)
 			out.WriteString("  <li>")
@@ -0,0 +1,81 @@
+package list
+
+import (
+	"io"
+	"strings"
+)
+
+// renderToChunkSize is the amount of output buffered before being written to
+// the io.Writer given to RenderTo (and its variants).
+const renderToChunkSize = 64 * 1024
+
+// renderTarget is the io.Writer given to RenderTo (and its variants) along with
+// the outcome of writing to it.
+type renderTarget struct {
+	err     error
+	w       io.Writer
+	written int64
+}
+
+func (rt *renderTarget) write(str string) {
+	if rt.err == nil && str != "" {
+		n, err := io.WriteString(rt.w, str)
+		rt.written += int64(n)
+		rt.err = err
+	}
+}
+
+// RenderTo renders the List like Render() does, but writes the output to the
+// given io.Writer in chunks as it is generated instead of building it all in
+// memory first. Returns the number of bytes written along with the first
+// error returned by the io.Writer, which stops the rendering early (ex.: on a
+// broken pipe). The output ends with a newline, as with SetOutputMirror().
+func (l *List) RenderTo(w io.Writer) (int64, error) {
+	return l.renderTo(w, l.Render)
+}
+
+// RenderHTMLTo renders the List like RenderHTML() to the given io.Writer. See
+// RenderTo() for details.
+func (l *List) RenderHTMLTo(w io.Writer) (int64, error) {
+	return l.renderTo(w, l.RenderHTML)
+}
+
+// RenderMarkdownTo renders the List like RenderMarkdown() to the given
+// io.Writer. See RenderTo() for details.
+func (l *List) RenderMarkdownTo(w io.Writer) (int64, error) {
+	return l.renderTo(w, l.RenderMarkdown)
+}
+
+func (l *List) renderTo(w io.Writer, render func() string) (int64, error) {
+	l.renderTarget = &renderTarget{w: w}
+	defer func() {
+		l.renderTarget = nil
+	}()
+
+	render()
+	return l.renderTarget.written, l.renderTarget.err
+}
+
+// estimatedRenderLength returns the size to pre-allocate for the output; this
+// is capped when rendering to an io.Writer in chunks.
+func (l *List) estimatedRenderLength() int {
+	if l.renderTarget != nil && l.approxSize > renderToChunkSize {
+		return renderToChunkSize
+	}
+	return l.approxSize
+}
+
+// renderToFlush writes the output rendered so far to the io.Writer given to
+// RenderTo once enough of it has been buffered; this is expected to be called
+// only in between items. Returns false if the rendering should stop because
+// the io.Writer returned an error.
+func (l *List) renderToFlush(out *strings.Builder) bool {
+	if l.renderTarget == nil {
+		return true
+	}
+	if out.Len() >= renderToChunkSize {
+		l.renderTarget.write(l.finalizeOutput(out.String()))
+		out.Reset()
+	}
+	return l.renderTarget.err == nil
+}
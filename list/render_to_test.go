@@ -0,0 +1,86 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type errorWriter struct {
+	err       error
+	numWrites int
+}
+
+func (w *errorWriter) Write(_ []byte) (int, error) {
+	w.numWrites++
+	return 0, w.err
+}
+
+func generateListForRenderTo(numItems int) *List {
+	lw := &List{}
+	for idx := 0; idx < numItems; idx++ {
+		lw.AppendItem(fmt.Sprintf("Item #%d", idx))
+		if idx%10 == 0 {
+			lw.Indent()
+		} else if idx%10 == 9 {
+			lw.UnIndentAll()
+		}
+	}
+	return lw
+}
+
+func TestList_RenderTo(t *testing.T) {
+	lw := generateListForRenderTo(10000)
+
+	for name, renderFuncs := range map[string]struct {
+		render   func() string
+		renderTo func(sb *strings.Builder) (int64, error)
+	}{
+		"default":  {lw.Render, func(sb *strings.Builder) (int64, error) { return lw.RenderTo(sb) }},
+		"html":     {lw.RenderHTML, func(sb *strings.Builder) (int64, error) { return lw.RenderHTMLTo(sb) }},
+		"markdown": {lw.RenderMarkdown, func(sb *strings.Builder) (int64, error) { return lw.RenderMarkdownTo(sb) }},
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected := renderFuncs.render() + "\n"
+			assert.Greater(t, len(expected), renderToChunkSize*2)
+
+			var out strings.Builder
+			n, err := renderFuncs.renderTo(&out)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(expected)), n)
+			assert.Equal(t, expected, out.String())
+		})
+	}
+}
+
+func TestList_RenderTo_EmptyList(t *testing.T) {
+	lw := &List{}
+
+	var out strings.Builder
+	n, err := lw.RenderTo(&out)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Empty(t, out.String())
+}
+
+func TestList_RenderTo_Error(t *testing.T) {
+	lw := generateListForRenderTo(10000)
+	errBrokenPipe := errors.New("broken pipe")
+
+	for name, renderTo := range map[string]func(w *errorWriter) (int64, error){
+		"default":  func(w *errorWriter) (int64, error) { return lw.RenderTo(w) },
+		"html":     func(w *errorWriter) (int64, error) { return lw.RenderHTMLTo(w) },
+		"markdown": func(w *errorWriter) (int64, error) { return lw.RenderMarkdownTo(w) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := &errorWriter{err: errBrokenPipe}
+			n, err := renderTo(w)
+			assert.Equal(t, errBrokenPipe, err)
+			assert.Zero(t, n)
+			assert.Equal(t, 1, w.numWrites)
+		})
+	}
+}
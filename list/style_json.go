@@ -0,0 +1,62 @@
+package list
+
+import "encoding/json"
+
+// styleJSON has the same fields as Style without any of its methods, and is
+// used to (un)marshal the Style without recursing into its own methods.
+type styleJSON Style
+
+// MarshalYAML returns the Style in a form that can be marshalled into YAML
+// by packages like gopkg.in/yaml.v3. The fields are the same as the ones in
+// the JSON form of the Style.
+func (s Style) MarshalYAML() (interface{}, error) {
+	b, err := json.Marshal(styleJSON(s))
+	if err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// UnmarshalJSON sets the Style from its JSON form. The Style is initialized
+// using the registered Style with the same Name (or StyleDefault if there is
+// no such Style), and is then overridden by the fields found in the JSON. For
+// ex.:
+//
+//	{"Name": "StyleConnectedRounded", "Format": "upper"}
+//
+// returns StyleConnectedRounded with the text in upper-case.
+func (s *Style) UnmarshalJSON(b []byte) error {
+	var named struct{ Name string }
+	if err := json.Unmarshal(b, &named); err != nil {
+		return err
+	}
+	base, ok := StyleByName(named.Name)
+	if !ok {
+		base = StyleDefault
+	}
+
+	style := styleJSON(base)
+	if err := json.Unmarshal(b, &style); err != nil {
+		return err
+	}
+	*s = Style(style)
+	return nil
+}
+
+// UnmarshalYAML sets the Style from its YAML form using the same rules as
+// UnmarshalJSON. Works with packages like gopkg.in/yaml.v3.
+func (s *Style) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(b)
+}
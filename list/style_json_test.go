@@ -0,0 +1,71 @@
+package list
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStyle_MarshalJSON(t *testing.T) {
+	for _, name := range StyleNames() {
+		style, _ := StyleByName(name)
+
+		b, err := json.Marshal(style)
+		assert.Nil(t, err, name)
+		var style2 Style
+		assert.Nil(t, json.Unmarshal(b, &style2), name)
+		assert.Equal(t, style, style2, name)
+	}
+}
+
+func TestStyle_UnmarshalJSON(t *testing.T) {
+	var style Style
+	err := json.Unmarshal([]byte(`{"Name": "StyleConnectedRounded", "Format": "upper"}`), &style)
+	assert.Nil(t, err)
+	expected := StyleConnectedRounded
+	expected.Format = text.FormatUpper
+	assert.Equal(t, expected, style)
+
+	err = json.Unmarshal([]byte(`{"Name": "MyStyle", "LinePrefix": "> "}`), &style)
+	assert.Nil(t, err)
+	expected = StyleDefault
+	expected.Name = "MyStyle"
+	expected.LinePrefix = "> "
+	assert.Equal(t, expected, style)
+
+	err = json.Unmarshal([]byte(`{"Format": "camel"}`), &style)
+	assert.EqualError(t, err, `invalid Format: "camel"`)
+}
+
+func TestStyle_MarshalYAML(t *testing.T) {
+	style := StyleBulletTriangle
+	style.CharNewline = "\r\n"
+
+	b, err := yaml.Marshal(style)
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), "Name: StyleBulletTriangle\n")
+
+	var style2 Style
+	assert.Nil(t, yaml.Unmarshal(b, &style2))
+	assert.Equal(t, style, style2)
+}
+
+func TestStyle_UnmarshalYAML(t *testing.T) {
+	var style Style
+	err := yaml.Unmarshal([]byte(`
+Name: StyleMarkdown
+CharItemSingle: "-"
+Format: title
+`), &style)
+	assert.Nil(t, err)
+
+	expected := StyleMarkdown
+	expected.CharItemSingle = "-"
+	expected.Format = text.FormatTitle
+	assert.Equal(t, expected, style)
+
+	assert.NotNil(t, yaml.Unmarshal([]byte("[foo]"), &style))
+}
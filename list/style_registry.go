@@ -0,0 +1,56 @@
+package list
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	stylesRegistered = map[string]Style{
+		StyleDefault.Name:          StyleDefault,
+		StyleBulletCircle.Name:     StyleBulletCircle,
+		StyleBulletFlower.Name:     StyleBulletFlower,
+		StyleBulletSquare.Name:     StyleBulletSquare,
+		StyleBulletStar.Name:       StyleBulletStar,
+		StyleBulletTriangle.Name:   StyleBulletTriangle,
+		StyleConnectedBold.Name:    StyleConnectedBold,
+		StyleConnectedDouble.Name:  StyleConnectedDouble,
+		StyleConnectedLight.Name:   StyleConnectedLight,
+		StyleConnectedRounded.Name: StyleConnectedRounded,
+		StyleMarkdown.Name:         StyleMarkdown,
+	}
+	stylesRegisteredMutex = sync.RWMutex{}
+)
+
+// RegisterStyle adds the Style to the registry so that it can be looked up
+// using StyleByName, or referred to by Name when unmarshalling a Style from
+// JSON/YAML. Registering a Style with the Name of an existing one replaces it.
+func RegisterStyle(style Style) {
+	stylesRegisteredMutex.Lock()
+	defer stylesRegisteredMutex.Unlock()
+
+	stylesRegistered[style.Name] = style
+}
+
+// StyleByName returns the registered Style with the given Name. All the
+// built-in Styles are registered by default (ex.: "StyleConnectedRounded").
+func StyleByName(name string) (Style, bool) {
+	stylesRegisteredMutex.RLock()
+	defer stylesRegisteredMutex.RUnlock()
+
+	style, ok := stylesRegistered[name]
+	return style, ok
+}
+
+// StyleNames returns the (sorted) names of all the registered Styles.
+func StyleNames() []string {
+	stylesRegisteredMutex.RLock()
+	defer stylesRegisteredMutex.RUnlock()
+
+	names := make([]string, 0, len(stylesRegistered))
+	for name := range stylesRegistered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,49 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterStyle(t *testing.T) {
+	style := StyleConnectedLight
+	style.Name = "StyleTestRegistered"
+	style.LinePrefix = "> "
+	RegisterStyle(style)
+	defer func() {
+		stylesRegisteredMutex.Lock()
+		delete(stylesRegistered, style.Name)
+		stylesRegisteredMutex.Unlock()
+	}()
+
+	registered, ok := StyleByName("StyleTestRegistered")
+	assert.True(t, ok)
+	assert.Equal(t, style, registered)
+	assert.Contains(t, StyleNames(), "StyleTestRegistered")
+}
+
+func TestStyleByName(t *testing.T) {
+	style, ok := StyleByName("StyleConnectedRounded")
+	assert.True(t, ok)
+	assert.Equal(t, StyleConnectedRounded, style)
+
+	_, ok = StyleByName("StyleFoo")
+	assert.False(t, ok)
+}
+
+func TestStyleNames(t *testing.T) {
+	assert.Equal(t, []string{
+		"StyleBulletCircle",
+		"StyleBulletFlower",
+		"StyleBulletSquare",
+		"StyleBulletStar",
+		"StyleBulletTriangle",
+		"StyleConnectedBold",
+		"StyleConnectedDouble",
+		"StyleConnectedLight",
+		"StyleConnectedRounded",
+		"StyleDefault",
+		"StyleMarkdown",
+	}, StyleNames())
+}
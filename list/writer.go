@@ -10,7 +10,10 @@ type Writer interface {
 	Length() int
 	Render() string
 	RenderHTML() string
+	RenderHTMLTo(w io.Writer) (int64, error)
 	RenderMarkdown() string
+	RenderMarkdownTo(w io.Writer) (int64, error)
+	RenderTo(w io.Writer) (int64, error)
 	Reset()
 	SetHTMLCSSClass(cssClass string)
 	SetOutputMirror(mirror io.Writer)
@@ -432,7 +432,7 @@ func (p *Progress) renderTrackers(lastRenderLength int) int {
 
 	// write the text to the output writer
 	p.outputWriterMutex.Lock()
-	_, _ = p.outputWriter.Write([]byte(out.String()))
+	_, _ = p.outputWriter.Write([]byte(text.DegradeRGBColors(out.String())))
 	p.outputWriterMutex.Unlock()
 
 	// stop if auto stop is enabled and there are no more active trackers
@@ -13,7 +13,7 @@ type Style struct {
 	Colors     StyleColors     // colors to use on the progress bar
 	Options    StyleOptions    // misc. options for the progress bar
 	Visibility StyleVisibility // show/hide components of the progress bar(s)
-	Renderer   StyleRenderer   // custom render functions for the progress bar
+	Renderer   StyleRenderer   `json:"-"` // custom render functions for the progress bar
 }
 
 var (
@@ -56,14 +56,14 @@ var (
 
 // StyleChars defines the characters/strings to use for rendering the Tracker.
 type StyleChars struct {
-	BoxLeft       string // left-border
-	BoxRight      string // right-border
-	Finished      string // finished block
-	Finished25    string // 25% finished block
-	Finished50    string // 50% finished block
-	Finished75    string // 75% finished block
-	Indeterminate IndeterminateIndicatorGenerator
-	Unfinished    string // 0% finished block
+	BoxLeft       string                          // left-border
+	BoxRight      string                          // right-border
+	Finished      string                          // finished block
+	Finished25    string                          // 25% finished block
+	Finished50    string                          // 50% finished block
+	Finished75    string                          // 75% finished block
+	Indeterminate IndeterminateIndicatorGenerator `json:"-"`
+	Unfinished    string                          // 0% finished block
 }
 
 var (
@@ -163,7 +163,7 @@ type StyleOptions struct {
 	PercentIndeterminate    string         // when percentage cannot be computed
 	SpeedPosition           Position       // where speed is displayed in stats
 	SpeedPrecision          time.Duration  // precision for speed
-	SpeedOverallFormatter   UnitsFormatter // formatter for the overall tracker speed
+	SpeedOverallFormatter   UnitsFormatter `json:"-"` // formatter for the overall tracker speed
 	SpeedSuffix             string         // suffix (/s)
 	TimeDonePrecision       time.Duration  // precision for time when done
 	TimeInProgressPrecision time.Duration  // precision for time when in progress
@@ -0,0 +1,61 @@
+package progress
+
+import (
+	"unicode/utf8"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// AutoStyle returns a Style suitable for the terminal attached to os.Stdout,
+// as detected by text.DetectCapabilities. StyleBlocks is used on terminals
+// that support Unicode, and StyleDefault on the rest.
+func AutoStyle() Style {
+	caps := text.DetectCapabilities()
+	if caps.Unicode {
+		return AdaptStyle(StyleBlocks, caps)
+	}
+	return AdaptStyle(StyleDefault, caps)
+}
+
+// AdaptStyle returns the Style adapted to render well on a terminal with the
+// given Capabilities:
+//   - the characters used for the progress bar are replaced with the ones in
+//     StyleCharsDefault if the terminal does not support Unicode
+//   - the colors are degraded to the closest ones supported by the terminal,
+//     or removed altogether if the terminal does not support colors
+func AdaptStyle(style Style, caps text.Capabilities) Style {
+	if !caps.Unicode && !style.Chars.isASCII() {
+		style.Chars = StyleCharsDefault
+	}
+
+	depth := caps.ColorDepth
+	style.Colors = StyleColors{
+		Message: style.Colors.Message.Degrade(depth),
+		Error:   style.Colors.Error.Degrade(depth),
+		Percent: style.Colors.Percent.Degrade(depth),
+		Pinned:  style.Colors.Pinned.Degrade(depth),
+		Stats:   style.Colors.Stats.Degrade(depth),
+		Time:    style.Colors.Time.Degrade(depth),
+		Tracker: style.Colors.Tracker.Degrade(depth),
+		Value:   style.Colors.Value.Degrade(depth),
+		Speed:   style.Colors.Speed.Degrade(depth),
+	}
+	return style
+}
+
+// isASCII returns true if all the characters in the StyleChars are ASCII. The
+// Indeterminate indicator cannot be inspected, and is not considered.
+func (sc StyleChars) isASCII() bool {
+	strs := []string{
+		sc.BoxLeft, sc.BoxRight, sc.Finished, sc.Finished25, sc.Finished50,
+		sc.Finished75, sc.Unfinished,
+	}
+	for _, str := range strs {
+		for idx := 0; idx < len(str); idx++ {
+			if str[idx] >= utf8.RuneSelf {
+				return false
+			}
+		}
+	}
+	return true
+}
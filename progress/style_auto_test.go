@@ -0,0 +1,44 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptStyle(t *testing.T) {
+	style := StyleCircle
+	style.Colors = StyleColorsExample
+	style.Colors.Percent = text.Colors{text.Fg256Color(196)}
+
+	t.Run("unicode and 256 colors", func(t *testing.T) {
+		caps := text.Capabilities{ColorDepth: text.ColorDepth256, IsTTY: true, Unicode: true}
+		styleAdapted := AdaptStyle(style, caps)
+		assert.Equal(t, withoutFuncs(style), withoutFuncs(styleAdapted))
+	})
+
+	t.Run("ascii and 16 colors", func(t *testing.T) {
+		caps := text.Capabilities{ColorDepth: text.ColorDepth16, IsTTY: true}
+		styleAdapted := AdaptStyle(style, caps)
+		assert.Equal(t, StyleCharsDefault.Finished, styleAdapted.Chars.Finished)
+		assert.Equal(t, StyleCharsDefault.BoxLeft, styleAdapted.Chars.BoxLeft)
+		assert.Equal(t, text.Colors{text.FgHiRed}, styleAdapted.Colors.Percent)
+		assert.Equal(t, StyleColorsExample.Pinned, styleAdapted.Colors.Pinned)
+	})
+
+	t.Run("no colors", func(t *testing.T) {
+		styleAdapted := AdaptStyle(StyleDefault, text.Capabilities{})
+		assert.Equal(t, StyleColors{}, styleAdapted.Colors)
+		assert.Equal(t, StyleCharsDefault.Finished, styleAdapted.Chars.Finished)
+	})
+}
+
+func TestAutoStyle(t *testing.T) {
+	style := AutoStyle()
+	if text.DetectCapabilities().Unicode {
+		assert.Equal(t, StyleBlocks.Name, style.Name)
+	} else {
+		assert.Equal(t, StyleDefault.Name, style.Name)
+	}
+}
@@ -0,0 +1,104 @@
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// styleJSON has the same fields as Style without any of its methods, and is
+// used to (un)marshal the Style without recursing into its own methods.
+type styleJSON Style
+
+// MarshalYAML returns the Style in a form that can be marshalled into YAML
+// by packages like gopkg.in/yaml.v3. The fields are the same as the ones in
+// the JSON form of the Style, with the colors written using their names (ex.:
+// "fg-hi-red", "bg-256:208"). The function fields (Chars.Indeterminate,
+// Options.SpeedOverallFormatter and Renderer) are not part of either form.
+func (s Style) MarshalYAML() (interface{}, error) {
+	b, err := json.Marshal(styleJSON(s))
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return jsonNumbersToValues(value), nil
+}
+
+// UnmarshalJSON sets the Style from its JSON form. The Style is initialized
+// using the registered Style with the same Name (or StyleDefault if there is
+// no such Style), and is then overridden by the fields found in the JSON. The
+// function fields are always taken from the registered Style. For ex.:
+//
+//	{"Name": "StyleBlocks", "Colors": {"Percent": ["fg-hi-red"]}}
+//
+// returns StyleBlocks with the percentage rendered in Hi-Red.
+func (s *Style) UnmarshalJSON(b []byte) error {
+	var named struct{ Name string }
+	if err := json.Unmarshal(b, &named); err != nil {
+		return err
+	}
+	base, ok := StyleByName(named.Name)
+	if !ok {
+		base = StyleDefault
+	}
+
+	// go through JSON to get a deep copy of the base Style, so that
+	// overriding the slices in it does not modify the registered Style
+	bBase, err := json.Marshal(styleJSON(base))
+	if err != nil {
+		return err
+	}
+	var style styleJSON
+	if err := json.Unmarshal(bBase, &style); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, &style); err != nil {
+		return err
+	}
+	style.Chars.Indeterminate = base.Chars.Indeterminate
+	style.Options.SpeedOverallFormatter = base.Options.SpeedOverallFormatter
+	style.Renderer = base.Renderer
+	*s = Style(style)
+	return nil
+}
+
+// UnmarshalYAML sets the Style from its YAML form using the same rules as
+// UnmarshalJSON. Works with packages like gopkg.in/yaml.v3.
+func (s *Style) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(b)
+}
+
+// jsonNumbersToValues replaces the json.Numbers in the decoded JSON with
+// int64 (or float64) values, so that durations do not get written out in
+// YAML in the exponent form of a float64.
+func jsonNumbersToValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = jsonNumbersToValues(val)
+		}
+	case []interface{}:
+		for idx, val := range v {
+			v[idx] = jsonNumbersToValues(val)
+		}
+	}
+	return value
+}
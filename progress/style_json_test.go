@@ -0,0 +1,114 @@
+package progress
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+// withoutFuncs returns the Style without the function fields, as functions
+// cannot be compared.
+func withoutFuncs(style Style) Style {
+	style.Chars.Indeterminate = nil
+	style.Options.SpeedOverallFormatter = nil
+	style.Renderer = StyleRenderer{}
+	return style
+}
+
+func TestStyle_MarshalJSON(t *testing.T) {
+	for _, name := range StyleNames() {
+		style, _ := StyleByName(name)
+
+		b, err := json.Marshal(style)
+		assert.Nil(t, err, name)
+		assert.NotContains(t, string(b), `"Indeterminate"`, name)
+		assert.NotContains(t, string(b), "SpeedOverallFormatter", name)
+		assert.NotContains(t, string(b), "Renderer", name)
+
+		var style2 Style
+		assert.Nil(t, json.Unmarshal(b, &style2), name)
+		assert.Equal(t, withoutFuncs(style), withoutFuncs(style2), name)
+		assert.NotNil(t, style2.Chars.Indeterminate, name)
+		assert.NotNil(t, style2.Options.SpeedOverallFormatter, name)
+	}
+
+	b, err := json.Marshal(StyleColorsExample)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Message":["fg-white"],`+
+		`"Error":["fg-red"],`+
+		`"Percent":["fg-hi-red"],`+
+		`"Pinned":["bg-hi-black","fg-white","bold"],`+
+		`"Stats":["fg-hi-black"],`+
+		`"Time":["fg-green"],`+
+		`"Tracker":["fg-yellow"],`+
+		`"Value":["fg-cyan"],`+
+		`"Speed":["fg-magenta"]}`, string(b))
+}
+
+func TestStyle_UnmarshalJSON(t *testing.T) {
+	style := StyleDefault
+	style.Renderer.TrackerIndeterminate = func(maxLen int) string { return "" }
+	style.Name = "StyleTestJSON"
+	RegisterStyle(style)
+	defer func() {
+		stylesRegisteredMutex.Lock()
+		delete(stylesRegistered, style.Name)
+		stylesRegisteredMutex.Unlock()
+	}()
+
+	var style2 Style
+	err := json.Unmarshal([]byte(`{
+		"Name": "StyleTestJSON",
+		"Colors": {"Percent": ["fg-hi-red"]},
+		"Options": {"DoneString": "ok!", "TimeOverallPrecision": 1000000},
+		"Visibility": {"ETA": true}
+	}`), &style2)
+	assert.Nil(t, err)
+
+	expected := withoutFuncs(style)
+	expected.Colors.Percent = text.Colors{text.FgHiRed}
+	expected.Options.DoneString = "ok!"
+	expected.Options.TimeOverallPrecision = time.Millisecond
+	expected.Visibility.ETA = true
+	assert.Equal(t, expected, withoutFuncs(style2))
+	assert.NotNil(t, style2.Renderer.TrackerIndeterminate)
+	assert.Nil(t, style2.Renderer.TrackerDeterminate)
+	assert.Nil(t, StyleDefault.Colors.Percent)
+
+	err = json.Unmarshal([]byte(`{"Colors": {"Error": [true]}}`), &style2)
+	assert.NotNil(t, err)
+}
+
+func TestStyle_MarshalYAML(t *testing.T) {
+	b, err := yaml.Marshal(StyleBlocks)
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), "    TimeOverallPrecision: 1000000000\n")
+
+	var style Style
+	assert.Nil(t, yaml.Unmarshal(b, &style))
+	assert.Equal(t, withoutFuncs(StyleBlocks), withoutFuncs(style))
+}
+
+func TestStyle_UnmarshalYAML(t *testing.T) {
+	var style Style
+	err := yaml.Unmarshal([]byte(`
+Name: StyleCircle
+Colors:
+  Tracker: [fg-256:33]
+Visibility:
+  Speed: true
+`), &style)
+	assert.Nil(t, err)
+
+	expected := withoutFuncs(StyleCircle)
+	expected.Colors.Tracker = text.Colors{text.Fg256Color(33)}
+	expected.Visibility.Speed = true
+	assert.Equal(t, expected, withoutFuncs(style))
+	assert.NotNil(t, style.Chars.Indeterminate)
+
+	assert.NotNil(t, yaml.Unmarshal([]byte("[foo]"), &style))
+}
@@ -0,0 +1,37 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterStyle(t *testing.T) {
+	style := StyleBlocks
+	style.Name = "StyleTestRegistered"
+	style.Colors = StyleColorsExample
+	RegisterStyle(style)
+	defer func() {
+		stylesRegisteredMutex.Lock()
+		delete(stylesRegistered, style.Name)
+		stylesRegisteredMutex.Unlock()
+	}()
+
+	registered, ok := StyleByName("StyleTestRegistered")
+	assert.True(t, ok)
+	assert.Equal(t, withoutFuncs(style), withoutFuncs(registered))
+	assert.Contains(t, StyleNames(), "StyleTestRegistered")
+}
+
+func TestStyleByName(t *testing.T) {
+	style, ok := StyleByName("StyleBlocks")
+	assert.True(t, ok)
+	assert.Equal(t, withoutFuncs(StyleBlocks), withoutFuncs(style))
+
+	_, ok = StyleByName("StyleFoo")
+	assert.False(t, ok)
+}
+
+func TestStyleNames(t *testing.T) {
+	assert.Equal(t, []string{"StyleBlocks", "StyleCircle", "StyleDefault", "StyleRhombus"}, StyleNames())
+}
@@ -1,7 +1,7 @@
 package progress
 
 import (
-	"fmt"
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 // UnitsNotationPosition determines notation position relative to unit value.
@@ -87,41 +87,10 @@ var (
 
 // FormatBytes formats the given value as a "Byte".
 func FormatBytes(value int64) string {
-	return formatNumber(value, map[int64]string{
-		1000000000000000: "PB",
-		1000000000000:    "TB",
-		1000000000:       "GB",
-		1000000:          "MB",
-		1000:             "KB",
-		0:                "B",
-	})
+	return text.FormatBytes(value, false, 2)
 }
 
 // FormatNumber formats the given value as a "regular number".
 func FormatNumber(value int64) string {
-	return formatNumber(value, map[int64]string{
-		1000000000000000: "Q",
-		1000000000000:    "T",
-		1000000000:       "B",
-		1000000:          "M",
-		1000:             "K",
-		0:                "",
-	})
-}
-
-var unitScales = []int64{
-	1000000000000000,
-	1000000000000,
-	1000000000,
-	1000000,
-	1000,
-}
-
-func formatNumber(value int64, notations map[int64]string) string {
-	for _, unitScale := range unitScales {
-		if value >= unitScale {
-			return fmt.Sprintf("%.2f%s", float64(value)/float64(unitScale), notations[unitScale])
-		}
-	}
-	return fmt.Sprintf("%d%s", value, notations[0])
+	return text.FormatNumberShort(value, 2)
 }
@@ -0,0 +1,210 @@
+package table
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// columnGroupTitleSeparator joins the titles of the groups and the column
+// header when the groups are flattened into the single header row allowed by
+// Markdown.
+const columnGroupTitleSeparator = " / "
+
+// ColumnGroup defines a title to be rendered above a range of columns, in a
+// header row of its own ("super header"). Groups may be nested; the wider ones
+// are rendered above the narrower ones they overlap.
+type ColumnGroup struct {
+	// Title is the text to render above the columns.
+	Title string
+	// FromCol is the # of the first column (from left) in the group. Note that
+	// this is not 0-indexed.
+	FromCol int
+	// ToCol is the # of the last column (from left) in the group, inclusive.
+	ToCol int
+
+	// Colors defines the colors to be used on the title
+	Colors text.Colors
+	// Align defines the horizontal alignment of the title; defaults to
+	// text.AlignCenter
+	Align text.Align
+}
+
+func (cg ColumnGroup) getAlign() text.Align {
+	if cg.Align == text.AlignDefault {
+		return text.AlignCenter
+	}
+	return cg.Align
+}
+
+// columnGroupRow is a header row generated from the column groups.
+type columnGroupRow struct {
+	// groups maps each column index to the group spanning it (if any)
+	groups map[int]*ColumnGroup
+	// spans maps the first column index of each group spanning more than one
+	// column to its last column index
+	spans mergedColumnIndices
+}
+
+// getAlign returns the alignment for the title in the given column.
+func (cgr columnGroupRow) getAlign(colIdx int) text.Align {
+	if group := cgr.groups[colIdx]; group != nil {
+		return group.getAlign()
+	}
+	return text.AlignDefault
+}
+
+// getColors returns the colors for the title in the given column.
+func (cgr columnGroupRow) getColors(colIdx int) text.Colors {
+	if group := cgr.groups[colIdx]; group != nil {
+		return group.Colors
+	}
+	return nil
+}
+
+// isSpanned returns true if both the columns belong to the same group.
+func (cgr columnGroupRow) isSpanned(colIdx1 int, colIdx2 int) bool {
+	if colIdx1 < 0 || colIdx2 < 0 {
+		return false
+	}
+	group := cgr.groups[colIdx1]
+	return group != nil && group == cgr.groups[colIdx2]
+}
+
+// columnGroupSpan is a column group mapped to the (visible) columns it spans.
+type columnGroupSpan struct {
+	group *ColumnGroup
+	first int
+	last  int
+	level int
+}
+
+func (cgs columnGroupSpan) overlaps(other columnGroupSpan) bool {
+	return cgs.first <= other.last && other.first <= cgs.last
+}
+
+// initForRenderColumnGroups generates the header rows for the column groups
+// and prepends them to the header. The given map translates the column indices
+// to the ones left after hiding columns (nil if none were hidden). In Markdown
+// mode, which allows only one header row, the group titles are prefixed to the
+// column names in the first header row instead.
+func (t *Table) initForRenderColumnGroups(colIdxMap map[int]int) {
+	t.columnGroupRows = nil
+	spans, numLevels := t.getColumnGroupSpans(colIdxMap)
+	if numLevels == 0 {
+		return
+	}
+
+	// the widest groups go on top
+	rows := make([]rowStr, numLevels)
+	t.columnGroupRows = make([]columnGroupRow, numLevels)
+	for rowIdx := range rows {
+		rows[rowIdx] = make(rowStr, t.numColumns)
+		t.columnGroupRows[rowIdx] = columnGroupRow{
+			groups: make(map[int]*ColumnGroup),
+			spans:  make(mergedColumnIndices),
+		}
+	}
+	for _, span := range spans {
+		rowIdx := numLevels - 1 - span.level
+		for colIdx := span.first; colIdx <= span.last; colIdx++ {
+			rows[rowIdx][colIdx] = t.directionModifier + span.group.Title
+			t.columnGroupRows[rowIdx].groups[colIdx] = span.group
+		}
+		if span.last > span.first {
+			t.columnGroupRows[rowIdx].spans[span.first] = span.last
+		}
+	}
+
+	if t.renderMode == renderModeMarkdown {
+		t.columnGroupRows = nil
+		t.initForRenderColumnGroupsFlattened(rows)
+		return
+	}
+	t.rowsHeader = append(rows, t.rowsHeader...)
+}
+
+// initForRenderColumnGroupsFlattened prefixes the titles of the groups to the
+// column names in the first header row.
+func (t *Table) initForRenderColumnGroupsFlattened(rows []rowStr) {
+	if len(t.rowsHeader) == 0 {
+		t.rowsHeader = []rowStr{make(rowStr, t.numColumns)}
+	}
+	header := t.rowsHeader[0]
+	for len(header) < t.numColumns {
+		header = append(header, "")
+	}
+	for colIdx := range header {
+		var titles []string
+		for _, row := range rows {
+			if row[colIdx] != "" {
+				titles = append(titles, row[colIdx])
+			}
+		}
+		if header[colIdx] != "" {
+			titles = append(titles, header[colIdx])
+		}
+		header[colIdx] = strings.Join(titles, columnGroupTitleSeparator)
+	}
+	t.rowsHeader[0] = header
+}
+
+// getColumnGroupRow returns the column group row being rendered as per the
+// hint, or nil if it is not one.
+func (t *Table) getColumnGroupRow(hint renderHint) *columnGroupRow {
+	if !hint.isHeaderRow || hint.isAutoIndexRow {
+		return nil
+	}
+	rowIdx := hint.rowNumber - 1
+	if rowIdx < 0 {
+		rowIdx = 0
+	}
+	if rowIdx < len(t.columnGroupRows) {
+		return &t.columnGroupRows[rowIdx]
+	}
+	return nil
+}
+
+// getColumnGroupSpans maps the column groups to the columns being rendered,
+// and assigns each one a level such that overlapping groups are rendered in
+// different rows with the narrower ones below the wider ones. Returns the spans
+// along with the number of levels.
+func (t *Table) getColumnGroupSpans(colIdxMap map[int]int) ([]columnGroupSpan, int) {
+	var spans []columnGroupSpan
+	for idx := range t.columnGroups {
+		span := columnGroupSpan{group: &t.columnGroups[idx], first: -1, last: -1}
+		for colIdx := span.group.FromCol - 1; colIdx < span.group.ToCol; colIdx++ {
+			newColIdx, ok := colIdx, true
+			if colIdxMap != nil {
+				newColIdx, ok = colIdxMap[colIdx]
+			}
+			if !ok || newColIdx < 0 || newColIdx >= t.numColumns {
+				continue
+			}
+			if span.first == -1 {
+				span.first = newColIdx
+			}
+			span.last = newColIdx
+		}
+		if span.first != -1 {
+			spans = append(spans, span)
+		}
+	}
+
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].last-spans[i].first < spans[j].last-spans[j].first
+	})
+	numLevels := 0
+	for idx := range spans {
+		for _, other := range spans[:idx] {
+			if spans[idx].overlaps(other) && other.level >= spans[idx].level {
+				spans[idx].level = other.level + 1
+			}
+		}
+		if spans[idx].level+1 > numLevels {
+			numLevels = spans[idx].level + 1
+		}
+	}
+	return spans, numLevels
+}
@@ -0,0 +1,249 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTableWithColumnGroups() Writer {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "Jan", "Feb", "Mar", "Apr", "May", "Jun"})
+	tw.AppendRow(Row{"Arya", 10, 20, 30, 40, 50, 60})
+	tw.AppendRow(Row{"Jon", 15, 25, 35, 45, 55, 65})
+	tw.AppendFooter(Row{"Total", 25, 45, 65, 85, 105, 125})
+	tw.SetColumnGroups([]ColumnGroup{
+		{Title: "Sales", FromCol: 2, ToCol: 4},
+		{Title: "Sales", FromCol: 5, ToCol: 7},
+		{Title: "First Half", FromCol: 2, ToCol: 7},
+	})
+	return tw
+}
+
+func TestTable_SetColumnGroups(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		tw := generateTableWithColumnGroups()
+
+		compareOutput(t, tw.Render(), `
++-------+-----------------------------------+
+|       |             FIRST HALF            |
++-------+-----------------+-----------------+
+|       |      SALES      |      SALES      |
++-------+-----+-----+-----+-----+-----+-----+
+| NAME  | JAN | FEB | MAR | APR | MAY | JUN |
++-------+-----+-----+-----+-----+-----+-----+
+| Arya  |  10 |  20 |  30 |  40 |  50 |  60 |
+| Jon   |  15 |  25 |  35 |  45 |  55 |  65 |
++-------+-----+-----+-----+-----+-----+-----+
+| TOTAL |  25 |  45 |  65 |  85 | 105 | 125 |
++-------+-----+-----+-----+-----+-----+-----+`)
+	})
+
+	t.Run("box styles", func(t *testing.T) {
+		tw := generateTableWithColumnGroups()
+		tw.SetStyle(StyleLight)
+		tw.SetAutoIndex(true)
+
+		compareOutput(t, tw.Render(), `
+┌───┬───────┬───────────────────────────────────┐
+│   │       │             FIRST HALF            │
+│   ├───────┼─────────────────┬─────────────────┤
+│   │       │      SALES      │      SALES      │
+│   ├───────┼─────┬─────┬─────┼─────┬─────┬─────┤
+│   │ NAME  │ JAN │ FEB │ MAR │ APR │ MAY │ JUN │
+├───┼───────┼─────┼─────┼─────┼─────┼─────┼─────┤
+│ 1 │ Arya  │  10 │  20 │  30 │  40 │  50 │  60 │
+│ 2 │ Jon   │  15 │  25 │  35 │  45 │  55 │  65 │
+├───┼───────┼─────┼─────┼─────┼─────┼─────┼─────┤
+│   │ TOTAL │  25 │  45 │  65 │  85 │ 105 │ 125 │
+└───┴───────┴─────┴─────┴─────┴─────┴─────┴─────┘`)
+
+		tw.SetAutoIndex(false)
+		tw.SetStyle(StyleDouble)
+		compareOutput(t, tw.Render(), `
+╔═══════╦═══════════════════════════════════╗
+║       ║             FIRST HALF            ║
+╠═══════╬═════════════════╦═════════════════╣
+║       ║      SALES      ║      SALES      ║
+╠═══════╬═════╦═════╦═════╬═════╦═════╦═════╣
+║ NAME  ║ JAN ║ FEB ║ MAR ║ APR ║ MAY ║ JUN ║
+╠═══════╬═════╬═════╬═════╬═════╬═════╬═════╣
+║ Arya  ║  10 ║  20 ║  30 ║  40 ║  50 ║  60 ║
+║ Jon   ║  15 ║  25 ║  35 ║  45 ║  55 ║  65 ║
+╠═══════╬═════╬═════╬═════╬═════╬═════╬═════╣
+║ TOTAL ║  25 ║  45 ║  65 ║  85 ║ 105 ║ 125 ║
+╚═══════╩═════╩═════╩═════╩═════╩═════╩═════╝`)
+	})
+
+	t.Run("colors and align", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Jan", "Feb", "Mar"})
+		tw.AppendRow(Row{1, 2, 3})
+		tw.SetColumnGroups([]ColumnGroup{
+			{Title: "Q1", FromCol: 1, ToCol: 2, Colors: text.Colors{text.FgRed}, Align: text.AlignLeft},
+		})
+		tw.SetStyle(StyleLight)
+		tw.Style().Options.DrawBorder = false
+
+		compareOutputColored(t, tw.Render(), ""+
+			"\x1b[31m Q1        \x1b[0m│     \n"+
+			"─────┬─────┼─────\n"+
+			" JAN │ FEB │ MAR \n"+
+			"─────┼─────┼─────\n"+
+			"   1 │   2 │   3 ")
+	})
+
+	t.Run("auto-merged header", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Name", "Jan", "Jan", "Mar"}, RowConfig{AutoMerge: true})
+		tw.AppendRow(Row{"Arya", 1, 2, 3})
+		tw.SetColumnGroups([]ColumnGroup{{Title: "Q1", FromCol: 2, ToCol: 4}})
+		tw.SetStyle(StyleLight)
+
+		compareOutput(t, tw.Render(), `
+┌──────┬─────────────┐
+│      │      Q1     │
+├──────┼───────┬─────┤
+│ NAME │  JAN  │ MAR │
+├──────┼───┬───┼─────┤
+│ Arya │ 1 │ 2 │   3 │
+└──────┴───┴───┴─────┘`)
+	})
+
+	t.Run("hidden columns", func(t *testing.T) {
+		tw := generateTableWithColumnGroups()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Feb", Hidden: true},
+			{Name: "Mar", Hidden: true},
+		})
+		tw.SetColumnGroups([]ColumnGroup{
+			{Title: "Q1", FromCol: 2, ToCol: 4},
+			{Title: "Q2", FromCol: 5, ToCol: 7},
+		})
+
+		compareOutput(t, tw.Render(), `
++-------+-----+-----------------+
+|       |  Q1 |        Q2       |
++-------+-----+-----+-----+-----+
+| NAME  | JAN | APR | MAY | JUN |
++-------+-----+-----+-----+-----+
+| Arya  |  10 |  40 |  50 |  60 |
+| Jon   |  15 |  45 |  55 |  65 |
++-------+-----+-----+-----+-----+
+| TOTAL |  25 |  85 | 105 | 125 |
++-------+-----+-----+-----+-----+`)
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+		tw := generateTableWithColumnGroups()
+		tw.SortBy([]SortBy{{Name: "Name", Mode: Dsc}})
+		tw.Style().Options.SeparateHeader = false
+
+		compareOutput(t, tw.Render(), `
++-------+-----------------------------------+
+|       |             FIRST HALF            |
+|       |      SALES      |      SALES      |
+| NAME  | JAN | FEB | MAR | APR | MAY | JUN |
+| Jon   |  15 |  25 |  35 |  45 |  55 |  65 |
+| Arya  |  10 |  20 |  30 |  40 |  50 |  60 |
++-------+-----+-----+-----+-----+-----+-----+
+| TOTAL |  25 |  45 |  65 |  85 | 105 | 125 |
++-------+-----+-----+-----+-----+-----+-----+`)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		tw := generateTableWithColumnGroups()
+
+		compareOutput(t, tw.RenderCSV(), `
+,First Half,First Half,First Half,First Half,First Half,First Half
+,Sales,Sales,Sales,Sales,Sales,Sales
+Name,Jan,Feb,Mar,Apr,May,Jun
+Arya,10,20,30,40,50,60
+Jon,15,25,35,45,55,65
+Total,25,45,65,85,105,125`)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		tw := generateTableWithColumnGroups()
+		tw.SetColumnGroups([]ColumnGroup{
+			{Title: "Q1", FromCol: 2, ToCol: 4, Align: text.AlignLeft},
+			{Title: "Q2", FromCol: 5, ToCol: 7, Colors: text.Colors{text.FgRed}},
+		})
+		tw.ResetRows()
+		tw.ResetFooters()
+
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>&nbsp;</th>
+    <th align="left" colspan=3>Q1</th>
+    <th align="center" class="fg-red" colspan=3>Q2</th>
+  </tr>
+  <tr>
+    <th align="right">Name</th>
+    <th align="right">Jan</th>
+    <th align="right">Feb</th>
+    <th align="right">Mar</th>
+    <th align="right">Apr</th>
+    <th align="right">May</th>
+    <th align="right">Jun</th>
+  </tr>
+  </thead>
+</table>`)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		tw := generateTableWithColumnGroups()
+
+		compareOutput(t, tw.RenderMarkdown(), `
+| Name | First Half / Sales / Jan | First Half / Sales / Feb | First Half / Sales / Mar | First Half / Sales / Apr | First Half / Sales / May | First Half / Sales / Jun |
+| --- | ---:| ---:| ---:| ---:| ---:| ---:|
+| Arya | 10 | 20 | 30 | 40 | 50 | 60 |
+| Jon | 15 | 25 | 35 | 45 | 55 | 65 |
+| Total | 25 | 45 | 65 | 85 | 105 | 125 |`)
+	})
+
+	t.Run("without header", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendRow(Row{1, 2, 3})
+		tw.SetColumnGroups([]ColumnGroup{{Title: "Q1", FromCol: 1, ToCol: 3}})
+
+		compareOutput(t, tw.Render(), `
++-----------+
+|     Q1    |
++---+---+---+
+| 1 | 2 | 3 |
++---+---+---+`)
+		compareOutput(t, tw.RenderMarkdown(), `
+| Q1 | Q1 | Q1 |
+| ---:| ---:| ---:|
+| 1 | 2 | 3 |`)
+	})
+}
+
+func TestTable_getColumnGroupSpans(t *testing.T) {
+	tw := Table{}
+	tw.AppendRow(Row{1, 2, 3, 4, 5, 6})
+	tw.SetColumnGroups([]ColumnGroup{
+		{Title: "A", FromCol: 1, ToCol: 6},
+		{Title: "B", FromCol: 1, ToCol: 3},
+		{Title: "C", FromCol: 2, ToCol: 2},
+		{Title: "D", FromCol: 5, ToCol: 9},
+		{Title: "E", FromCol: 4, ToCol: 3},
+	})
+	tw.initForRender(renderModeDefault)
+
+	spans, numLevels := tw.getColumnGroupSpans(nil)
+	assert.Equal(t, 3, numLevels)
+	levels := make(map[string]int)
+	for _, span := range spans {
+		levels[span.group.Title] = span.level
+	}
+	assert.Equal(t, map[string]int{"A": 2, "B": 1, "C": 0, "D": 0}, levels)
+	assert.Len(t, tw.columnGroupRows, 3)
+	assert.Equal(t, mergedColumnIndices{0: 5}, tw.columnGroupRows[0].spans)
+	assert.Equal(t, mergedColumnIndices{0: 2}, tw.columnGroupRows[1].spans)
+	assert.Equal(t, mergedColumnIndices{4: 5}, tw.columnGroupRows[2].spans)
+}
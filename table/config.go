@@ -21,6 +21,9 @@ type ColumnConfig struct {
 	AlignFooter text.Align
 	// AlignHeader defines the horizontal alignment of Header rows
 	AlignHeader text.Align
+	// DecimalSeparator is the separator the numbers are lined up on when
+	// Align is text.AlignDecimal; default: "."
+	DecimalSeparator string
 
 	// AutoMerge merges cells with similar values and prevents separators from
 	// being drawn. Caveats:
@@ -33,6 +36,22 @@ type ColumnConfig struct {
 	// * Style().Color.Row == Style().Color.RowAlternate (or not set)
 	AutoMerge bool
 
+	// Compute computes the value of the column in each (regular) row from the
+	// other values in the row, before the rows get filtered and sorted. If
+	// the Name is not found in the header (and no Number is given), a virtual
+	// column is added after all the other columns with Name as its header.
+	// Computed columns are filled in from left to right, so one can make use
+	// of the ones to its left.
+	Compute func(row Row) interface{}
+	// ComputeFooter computes the value of the column in the first Footer row
+	// (which gets added if there are none) from the rows left after filtering,
+	// like a total or an average. The rows left out by Table.Limit and
+	// Table.Offset are included unless ComputeFooterFromVisibleRows is set.
+	ComputeFooter func(rows []Row) interface{}
+	// ComputeFooterFromVisibleRows makes ComputeFooter work on just the rows
+	// being rendered.
+	ComputeFooterFromVisibleRows bool
+
 	// Colors defines the colors to be used on the column
 	Colors text.Colors
 	// ColorsFooter defines the colors to be used on the column in Footer rows
@@ -53,6 +72,18 @@ type ColumnConfig struct {
 	TransformerFooter text.Transformer
 	// TransformerHeader is like Transformer but for Header rows
 	TransformerHeader text.Transformer
+	// TransformerWithContext is like Transformer, but gets the entire row
+	// along with the value (refer to text.TransformContext) so that the
+	// column can show values from other columns. The columns are named as in
+	// the first Header row, or as A, B, C, etc. without one. Takes precedence
+	// over Transformer for the regular rows.
+	TransformerWithContext text.ContextTransformer
+
+	// TreeColumn makes the column show the connectors between the rows
+	// appended with AppendChildRow and their parents (refer to
+	// Style().Tree); defaults to the first column when no column is marked
+	// as such.
+	TreeColumn bool
 
 	// VAlign defines the vertical alignment
 	VAlign text.VAlign
@@ -93,6 +124,11 @@ type RowConfig struct {
 
 	// Alignment to use on a merge (defaults to text.AlignCenter)
 	AutoMergeAlign text.Align
+
+	// Collapsed hides the child rows (refer to AppendChildRow) of the row, and
+	// shows the number of rows hidden under it (ex.: "(+3 children)") in the
+	// tree column instead.
+	Collapsed bool
 }
 
 func (rc RowConfig) getAutoMergeAlign() text.Align {
@@ -101,3 +137,15 @@ func (rc RowConfig) getAutoMergeAlign() text.Align {
 	}
 	return rc.AutoMergeAlign
 }
+
+// copyRowConfigMap returns a copy of the RowConfig map.
+func copyRowConfigMap(configs map[int]RowConfig) map[int]RowConfig {
+	if configs == nil {
+		return nil
+	}
+	configsCopy := make(map[int]RowConfig, len(configs))
+	for rowIdx, config := range configs {
+		configsCopy[rowIdx] = config
+	}
+	return configsCopy
+}
@@ -0,0 +1,169 @@
+package table
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// Describe related constants
+const (
+	describeTypeNumber = "number"
+	describeTypeText   = "text"
+	describeTypeTime   = "time"
+)
+
+// Describe returns a new Writer that summarizes the values in each column of
+// the Table (after filtering, and including the computed columns), with one
+// row per column containing:
+//   - Type: "number" if all the values are numbers (the same rule that aligns
+//     a column to the right), "time" if all the values are time.Time, or
+//     "text" otherwise
+//   - Count: the number of values, and Nulls: the number of nil/missing values
+//   - Distinct: the number of distinct values
+//   - Min/Max/Mean: of the numbers (Min/Max only for the times)
+//   - Longest: the length of the longest value
+//
+// The stats are computed from the raw values appended, and not the rendered
+// ones (after Transformers, etc.). Example:
+//
+//	+--------+--------+-------+-------+----------+------+--------+--------+---------+
+//	| COLUMN | TYPE   | COUNT | NULLS | DISTINCT | MIN  | MAX    | MEAN   | LONGEST |
+//	+--------+--------+-------+-------+----------+------+--------+--------+---------+
+//	| Name   | text   |     2 |     1 |        2 |      |        |        |       4 |
+//	| Salary | number |     3 |     0 |        3 | 2000 | 5000.5 | 3333.5 |       6 |
+//	+--------+--------+-------+-------+----------+------+--------+--------+---------+
+//	3 rows
+func (t *Table) Describe() Writer {
+	src, header := t.cloneFiltered()
+	numColumns := len(header)
+	for _, row := range src.rowsRawFiltered {
+		if len(row) > numColumns {
+			numColumns = len(row)
+		}
+	}
+
+	tw := &Table{}
+	if t.style != nil {
+		tw.SetStyle(*t.style)
+	}
+	tw.AppendHeader(Row{"Column", "Type", "Count", "Nulls", "Distinct", "Min", "Max", "Mean", "Longest"})
+	for colIdx := 0; colIdx < numColumns; colIdx++ {
+		name := AutoIndexColumnID(colIdx)
+		if colIdx < len(header) && fmt.Sprint(header[colIdx]) != "" {
+			name = fmt.Sprint(header[colIdx])
+		}
+		stats := newDescribeStats()
+		for _, row := range src.rowsRawFiltered {
+			if colIdx < len(row) {
+				stats.add(cellValueOf(row[colIdx]))
+			} else {
+				stats.nulls++
+			}
+		}
+		tw.AppendRow(stats.row(name))
+	}
+	if numRows := len(src.rowsRawFiltered); numRows == 1 {
+		tw.SetCaption("1 row")
+	} else {
+		tw.SetCaption("%d rows", numRows)
+	}
+	return tw
+}
+
+// describeStats accumulates the stats for a column for Describe.
+type describeStats struct {
+	count      int
+	distinct   map[string]bool
+	longest    int
+	nonNumeric bool
+	nonTime    bool
+	nulls      int
+	// numbers
+	numMax    interface{}
+	numMaxVal float64
+	numMin    interface{}
+	numMinVal float64
+	numSum    float64
+	// times
+	timeMax time.Time
+	timeMin time.Time
+}
+
+func newDescribeStats() *describeStats {
+	return &describeStats{distinct: make(map[string]bool)}
+}
+
+func (ds *describeStats) add(val interface{}) {
+	// nil is not a number, just like in the column alignment logic
+	if !isNumber(val) {
+		ds.nonNumeric = true
+	}
+	if val == nil {
+		ds.nulls++
+		return
+	}
+
+	ds.count++
+	ds.distinct[fmt.Sprintf("%T:%v", val, val)] = true
+	if length := text.StringWidthWithoutEscSequences(convertValueToString(val)); length > ds.longest {
+		ds.longest = length
+	}
+	if isNumber(val) {
+		num := describeNumberToFloat64(val)
+		if ds.numMin == nil || num < ds.numMinVal {
+			ds.numMin, ds.numMinVal = val, num
+		}
+		if ds.numMax == nil || num > ds.numMaxVal {
+			ds.numMax, ds.numMaxVal = val, num
+		}
+		ds.numSum += num
+	}
+	if tm, ok := val.(time.Time); ok {
+		if ds.timeMin.IsZero() || tm.Before(ds.timeMin) {
+			ds.timeMin = tm
+		}
+		if ds.timeMax.IsZero() || tm.After(ds.timeMax) {
+			ds.timeMax = tm
+		}
+	} else {
+		ds.nonTime = true
+	}
+}
+
+func (ds *describeStats) columnType() string {
+	if !ds.nonNumeric {
+		return describeTypeNumber
+	} else if ds.count > 0 && ds.nulls == 0 && !ds.nonTime {
+		return describeTypeTime
+	}
+	return describeTypeText
+}
+
+func (ds *describeStats) row(name string) Row {
+	var valMin, valMax, valMean interface{} = "", "", ""
+	switch colType := ds.columnType(); {
+	case colType == describeTypeNumber && ds.count > 0:
+		valMin, valMax = ds.numMin, ds.numMax
+		valMean = math.Round(ds.numSum/float64(ds.count)*100) / 100
+	case colType == describeTypeTime:
+		valMin, valMax = ds.timeMin, ds.timeMax
+	}
+	return Row{name, ds.columnType(), ds.count, ds.nulls, len(ds.distinct), valMin, valMax, valMean, ds.longest}
+}
+
+// describeNumberToFloat64 converts the number (refer to isNumber) to a float64.
+func describeNumberToFloat64(val interface{}) float64 {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	default:
+		return rv.Float()
+	}
+}
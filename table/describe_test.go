@@ -0,0 +1,113 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTableForDescribe() Writer {
+	joined := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name", "Salary", "Joined", ""})
+	tw.AppendRows([]Row{
+		{1, "Arya", 3000, joined},
+		{2, "Jon", 2000, joined.AddDate(1, 0, 0), "x"},
+		{3, nil, 5000.5, joined.AddDate(-1, 0, 0)},
+		{4, "Jon", 2000, joined},
+	})
+	return tw
+}
+
+func TestTable_Describe(t *testing.T) {
+	tw := generateTableForDescribe()
+
+	compareOutput(t, tw.Describe().Render(), `
++--------+--------+-------+-------+----------+-------------------------------+-------------------------------+---------+---------+
+| COLUMN | TYPE   | COUNT | NULLS | DISTINCT | MIN                           | MAX                           | MEAN    | LONGEST |
++--------+--------+-------+-------+----------+-------------------------------+-------------------------------+---------+---------+
+| #      | number |     4 |     0 |        4 | 1                             | 4                             | 2.5     |       1 |
+| Name   | text   |     3 |     1 |        2 |                               |                               |         |       4 |
+| Salary | number |     4 |     0 |        3 | 2000                          | 5000.5                        | 3000.13 |       6 |
+| Joined | time   |     4 |     0 |        3 | 2019-01-02 00:00:00 +0000 UTC | 2021-01-02 00:00:00 +0000 UTC |         |      29 |
+| E      | text   |     1 |     3 |        1 |                               |                               |         |       1 |
++--------+--------+-------+-------+----------+-------------------------------+-------------------------------+---------+---------+
+4 rows`)
+
+	t.Run("computed and filtered", func(t *testing.T) {
+		tw := generateTableForDescribe()
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Bonus", Compute: func(row Row) interface{} {
+			if salary, ok := row[2].(int); ok {
+				return salary / 10
+			}
+			return nil
+		}}})
+		tw.FilterBy([]FilterBy{{Number: 1, Operator: LessThan, Value: 4}})
+
+		compareOutput(t, tw.Describe().RenderCSV(), `
+Column,Type,Count,Nulls,Distinct,Min,Max,Mean,Longest
+#,number,3,0,3,1,3,2,1
+Name,text,2,1,2,,,,4
+Salary,number,3,0,3,2000,5000.5,3333.5,6
+Joined,time,3,0,3,2019-01-02 00:00:00 +0000 UTC,2021-01-02 00:00:00 +0000 UTC,,29
+E,text,3,0,2,,,,1
+Bonus,text,2,1,2,,,,3
+3 rows`)
+	})
+
+	t.Run("does not change the table", func(t *testing.T) {
+		tw := generateTableForDescribe()
+		tw.SetStyle(StyleLight)
+		out := tw.Render()
+
+		describe := tw.Describe()
+		assert.Equal(t, StyleLight.Name, describe.Style().Name)
+		assert.Equal(t, 5, describe.Length())
+		assert.Equal(t, out, tw.Render())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		describe := NewWriter().Describe()
+		assert.Equal(t, 0, describe.Length())
+		compareOutput(t, describe.RenderCSV(), `
+Column,Type,Count,Nulls,Distinct,Min,Max,Mean,Longest
+0 rows`)
+	})
+
+	t.Run("no header", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendRows([]Row{{"a", 1}, {"b", uint8(2)}, {"a", -3.5}})
+
+		compareOutput(t, tw.Describe().RenderCSV(), `
+Column,Type,Count,Nulls,Distinct,Min,Max,Mean,Longest
+A,text,3,0,2,,,,1
+B,number,3,0,3,-3.5,2,-0.17,4
+3 rows`)
+	})
+
+	t.Run("sync writer", func(t *testing.T) {
+		sw := NewSyncWriter()
+		sw.AppendHeader(Row{"Name", "Salary"})
+		sw.AppendRows([]Row{{"Arya", 3000}, {"Jon", 2000}})
+
+		compareOutput(t, sw.Describe().RenderCSV(), `
+Column,Type,Count,Nulls,Distinct,Min,Max,Mean,Longest
+Name,text,2,0,2,,,,4
+Salary,number,2,0,2,2000,3000,2500,4
+2 rows`)
+	})
+
+	t.Run("one row", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Name", "Salary"})
+		tw.AppendRow(Row{"Arya", 3000})
+
+		compareOutput(t, tw.Describe().RenderCSV(), `
+Column,Type,Count,Nulls,Distinct,Min,Max,Mean,Longest
+Name,text,1,0,1,,,,4
+Salary,number,1,0,1,3000,3000,3000,4
+1 row`)
+	})
+}
@@ -0,0 +1,337 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// diffKind denotes the kind of change a cell/row went through between the two
+// snapshots compared by Diff.
+type diffKind int
+
+const (
+	diffKindUnchanged diffKind = iota
+	diffKindAdded
+	diffKindRemoved
+	diffKindChanged
+)
+
+// Diff related constants
+const (
+	diffMarkerAdded   = "+"
+	diffMarkerChanged = "~"
+	diffMarkerRemoved = "-"
+	diffSeparator     = " → "
+)
+
+// Diff related variables
+var (
+	colorsDiffAdded   = text.Colors{text.FgGreen}
+	colorsDiffChanged = text.Colors{text.FgYellow}
+	colorsDiffRemoved = text.Colors{text.FgRed}
+)
+
+// Diff compares two snapshots of a table and returns a new Writer that shows
+// the differences between them. Rows are matched using the values in the
+// column named keyColumn (as it appears in the first Header row, or as A, B,
+// C, etc. without one); if the column cannot be found, the returned table has
+// no rows, and the caption says so.
+//
+// The returned table has a leading marker column and renders:
+//   - added rows with a "+" marker in Green
+//   - removed rows with a "-" marker in Red
+//   - changed rows with a "~" marker, and the changed cells as "old → new" in
+//     Yellow
+//
+// The caption summarizes the number of added, removed and changed rows. In
+// HTML mode, the changes are rendered using <ins> and <del> tags instead of
+// colors. Example:
+//
+//	┌───┬───────┬───────┬──────────┐
+//	│   │ NAME  │ STATE │ REPLICAS │
+//	├───┼───────┼───────┼──────────┤
+//	│ ~ │ api   │ ready │    2 → 3 │
+//	│ - │ db    │ ready │        1 │
+//	│   │ cache │ ready │        1 │
+//	│ + │ web   │ ready │        2 │
+//	└───┴───────┴───────┴──────────┘
+//	1 added, 1 removed, 1 changed
+func Diff(before Writer, after Writer, keyColumn string) Writer {
+	tBefore, tAfter := writerAsTable(before), writerAsTable(after)
+	columns := diffColumns(tBefore, tAfter)
+	rowsBefore := diffAlignRows(tBefore, columns)
+	rowsAfter := diffAlignRows(tAfter, columns)
+	keyIdx := -1
+	for idx, column := range columns {
+		if column == keyColumn {
+			keyIdx = idx
+			break
+		}
+	}
+
+	tw := &Table{}
+	if tAfter.style != nil {
+		tw.SetStyle(*tAfter.style)
+	}
+	tw.title = tAfter.title
+	if len(columns) > 0 && (len(tBefore.rowsHeaderRaw) > 0 || len(tAfter.rowsHeaderRaw) > 0) {
+		header := Row{""}
+		for _, column := range columns {
+			header = append(header, column)
+		}
+		tw.AppendHeader(header)
+	}
+	// matching the rows by anything but the key would report made-up changes
+	if keyIdx < 0 {
+		if len(columns) > 0 {
+			tw.SetCaption("unknown key column %q", keyColumn)
+		}
+		return tw
+	}
+
+	// match every row in "after" to the first unmatched row in "before"
+	// having the same key
+	beforeIndices := make(map[string][]int)
+	for rowIdx, row := range rowsBefore {
+		key := diffValueString(row[keyIdx])
+		beforeIndices[key] = append(beforeIndices[key], rowIdx)
+	}
+	matches := make([]int, len(rowsAfter))
+	matched := make([]bool, len(rowsBefore))
+	for rowIdx, row := range rowsAfter {
+		matches[rowIdx] = -1
+		key := diffValueString(row[keyIdx])
+		if indices := beforeIndices[key]; len(indices) > 0 {
+			matches[rowIdx] = indices[0]
+			matched[indices[0]] = true
+			beforeIndices[key] = indices[1:]
+		}
+	}
+
+	// removed rows are rendered right after the row that preceded them in
+	// "before", so that they appear close to where they used to be
+	numAdded, numChanged, numRemoved := 0, 0, 0
+	appendRemovedRows := func(fromIdx int) {
+		for rowIdx := fromIdx; rowIdx < len(rowsBefore) && !matched[rowIdx]; rowIdx++ {
+			tw.AppendRow(diffRow(diffKindRemoved, rowsBefore[rowIdx], nil))
+			numRemoved++
+		}
+	}
+	appendRemovedRows(0)
+	for rowIdx, row := range rowsAfter {
+		if beforeIdx := matches[rowIdx]; beforeIdx >= 0 {
+			rowDiff := diffRow(diffKindChanged, rowsBefore[beforeIdx], row)
+			if rowDiff[0].(diffCell).kind == diffKindChanged {
+				numChanged++
+			}
+			tw.AppendRow(rowDiff)
+			appendRemovedRows(beforeIdx + 1)
+		} else {
+			tw.AppendRow(diffRow(diffKindAdded, nil, row))
+			numAdded++
+		}
+	}
+	tw.SetCaption("%d added, %d removed, %d changed", numAdded, numRemoved, numChanged)
+	return tw
+}
+
+// diffCell is a single cell in the table generated by Diff.
+type diffCell struct {
+	kind     diffKind
+	valOld   interface{}
+	valNew   interface{}
+	isMarker bool
+}
+
+func (d diffCell) cellValue() interface{} {
+	if d.isMarker {
+		return ""
+	}
+	if d.kind == diffKindRemoved {
+		return d.valOld
+	}
+	return d.valNew
+}
+
+func (d diffCell) renderCell(t *Table, colIdx int, hint renderHint) string {
+	var strOld, strNew string
+	if d.valOld != nil {
+		strOld = t.stringifyValue(colIdx, d.valOld, hint)
+	}
+	if d.valNew != nil {
+		strNew = t.stringifyValue(colIdx, d.valNew, hint)
+	}
+
+	switch t.renderMode {
+	case renderModeDefault:
+		switch d.kind {
+		case diffKindAdded:
+			return colorsDiffAdded.Sprint(strNew)
+		case diffKindRemoved:
+			return colorsDiffRemoved.Sprint(strOld)
+		case diffKindChanged:
+			return colorsDiffChanged.Sprint(d.changedString(strOld, strNew))
+		}
+	case renderModeHTML:
+		strOld, strNew = t.htmlEscape(strOld), t.htmlEscape(strNew)
+		switch d.kind {
+		case diffKindAdded:
+			return diffHTMLTag("ins", strNew)
+		case diffKindRemoved:
+			return diffHTMLTag("del", strOld)
+		case diffKindChanged:
+			if d.isMarker {
+				return strNew
+			}
+			return diffHTMLTag("del", strOld) + diffSeparator + diffHTMLTag("ins", strNew)
+		}
+		return strNew
+	default:
+		if d.kind == diffKindChanged {
+			return d.changedString(strOld, strNew)
+		}
+	}
+	if d.kind == diffKindRemoved {
+		return strOld
+	}
+	return strNew
+}
+
+func (d diffCell) changedString(strOld string, strNew string) string {
+	if d.isMarker {
+		return strNew
+	}
+	return strOld + diffSeparator + strNew
+}
+
+// diffAlignRows returns the rows of the Table re-arranged to match the given
+// column order; columns not present in the Table are left as nil.
+func diffAlignRows(t *Table, columns []string) []Row {
+	var header Row
+	if len(t.rowsHeaderRaw) > 0 {
+		header = t.rowsHeaderRaw[0]
+	}
+	srcIndices := make([]int, len(columns))
+	for idx, column := range columns {
+		if header != nil {
+			srcIndices[idx] = header.findColumnNumber(column) - 1
+		} else {
+			srcIndices[idx] = idx
+		}
+	}
+
+	rows := make([]Row, len(t.rowsRaw))
+	for rowIdx, rowRaw := range t.rowsRaw {
+		row := make(Row, len(columns))
+		for colIdx, srcIdx := range srcIndices {
+			if srcIdx >= 0 && srcIdx < len(rowRaw) {
+				row[colIdx] = rowRaw[srcIdx]
+			}
+		}
+		rows[rowIdx] = row
+	}
+	return rows
+}
+
+// diffColumns returns the names of all the columns in the "after" table
+// followed by the ones found only in the "before" table. Without any header
+// rows, the columns are named like the auto-index columns (A, B, C, ...).
+func diffColumns(tBefore *Table, tAfter *Table) []string {
+	var columns []string
+	seen := make(map[string]bool)
+	numColumns := 0
+	for _, t := range []*Table{tAfter, tBefore} {
+		if len(t.rowsHeaderRaw) > 0 {
+			for _, col := range t.rowsHeaderRaw[0] {
+				if column := fmt.Sprint(col); !seen[column] {
+					columns = append(columns, column)
+					seen[column] = true
+				}
+			}
+		}
+		for _, row := range t.rowsRaw {
+			if len(row) > numColumns {
+				numColumns = len(row)
+			}
+		}
+	}
+	if len(columns) == 0 {
+		for colIdx := 0; colIdx < numColumns; colIdx++ {
+			columns = append(columns, AutoIndexColumnID(colIdx))
+		}
+	}
+	return columns
+}
+
+func diffHTMLTag(tag string, str string) string {
+	if str == "" {
+		return ""
+	}
+	return "<" + tag + ">" + str + "</" + tag + ">"
+}
+
+// diffRow generates the row to render by comparing the two versions of it.
+func diffRow(kind diffKind, rowOld Row, rowNew Row) Row {
+	numColumns := len(rowOld)
+	if len(rowNew) > numColumns {
+		numColumns = len(rowNew)
+	}
+
+	row := make(Row, numColumns+1)
+	rowKind := kind
+	if kind == diffKindChanged {
+		rowKind = diffKindUnchanged
+	}
+	for colIdx := 0; colIdx < numColumns; colIdx++ {
+		var valOld, valNew interface{}
+		if colIdx < len(rowOld) {
+			valOld = rowOld[colIdx]
+		}
+		if colIdx < len(rowNew) {
+			valNew = rowNew[colIdx]
+		}
+
+		switch {
+		case kind != diffKindChanged:
+			row[colIdx+1] = diffCell{kind: kind, valOld: valOld, valNew: valNew}
+		case diffValueString(valOld) != diffValueString(valNew):
+			row[colIdx+1] = diffCell{kind: kind, valOld: valOld, valNew: valNew}
+			rowKind = diffKindChanged
+		default:
+			row[colIdx+1] = diffCell{kind: diffKindUnchanged, valOld: valOld, valNew: valNew}
+		}
+	}
+
+	marker := ""
+	switch rowKind {
+	case diffKindAdded:
+		marker = diffMarkerAdded
+	case diffKindChanged:
+		marker = diffMarkerChanged
+	case diffKindRemoved:
+		marker = diffMarkerRemoved
+	}
+	row[0] = diffCell{kind: rowKind, valOld: marker, valNew: marker, isMarker: true}
+	return row
+}
+
+// diffValueString returns the string form of the value for comparisons.
+func diffValueString(val interface{}) string {
+	if val == nil {
+		return ""
+	}
+	return convertValueToString(val)
+}
+
+// writerAsTable returns the Table behind the Writer (a snapshot of it for a
+// SyncWriter); any other Writer is treated as an empty Table.
+func writerAsTable(w Writer) *Table {
+	if t, ok := w.(*Table); ok && t != nil {
+		return t
+	}
+	if sw, ok := w.(*SyncWriter); ok && sw != nil {
+		return sw.Clone()
+	}
+	return &Table{}
+}
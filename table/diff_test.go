@@ -0,0 +1,196 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDiffTables() (Writer, Writer) {
+	before := NewWriter()
+	before.AppendHeader(Row{"Name", "State", "Replicas"})
+	before.AppendRows([]Row{
+		{"api", "ready", 2},
+		{"db", "ready", 1},
+		{"cache", "ready", 1},
+	})
+
+	after := NewWriter()
+	after.AppendHeader(Row{"Name", "State", "Replicas"})
+	after.AppendRows([]Row{
+		{"api", "ready", 3},
+		{"cache", "ready", 1},
+		{"web", "<new>", 2},
+	})
+	return before, after
+}
+
+func TestDiff(t *testing.T) {
+	before, after := testDiffTables()
+
+	tw := Diff(before, after, "Name")
+	tw.SetStyle(StyleLight)
+	compareOutputColored(t, tw.Render(), ""+
+		"┌───┬───────┬───────┬──────────┐\n"+
+		"│   │ NAME  │ STATE │ REPLICAS │\n"+
+		"├───┼───────┼───────┼──────────┤\n"+
+		"│ \x1b[33m~\x1b[0m │ api   │ ready │    \x1b[33m2 → 3\x1b[0m │\n"+
+		"│ \x1b[31m-\x1b[0m │ \x1b[31mdb\x1b[0m    │ \x1b[31mready\x1b[0m │        \x1b[31m1\x1b[0m │\n"+
+		"│   │ cache │ ready │        1 │\n"+
+		"│ \x1b[32m+\x1b[0m │ \x1b[32mweb\x1b[0m   │ \x1b[32m<new>\x1b[0m │        \x1b[32m2\x1b[0m │\n"+
+		"└───┴───────┴───────┴──────────┘\n"+
+		"1 added, 1 removed, 1 changed")
+
+	t.Run("csv", func(t *testing.T) {
+		compareOutput(t, tw.RenderCSV(), `
+,Name,State,Replicas
+~,api,ready,2 → 3
+-,db,ready,1
+,cache,ready,1
++,web,<new>,2
+1 added, 1 removed, 1 changed`)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>&nbsp;</th>
+    <th>Name</th>
+    <th>State</th>
+    <th align="right">Replicas</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>~</td>
+    <td>api</td>
+    <td>ready</td>
+    <td align="right"><del>2</del> → <ins>3</ins></td>
+  </tr>
+  <tr>
+    <td><del>-</del></td>
+    <td><del>db</del></td>
+    <td><del>ready</del></td>
+    <td align="right"><del>1</del></td>
+  </tr>
+  <tr>
+    <td>&nbsp;</td>
+    <td>cache</td>
+    <td>ready</td>
+    <td align="right">1</td>
+  </tr>
+  <tr>
+    <td><ins>+</ins></td>
+    <td><ins>web</ins></td>
+    <td><ins>&lt;new&gt;</ins></td>
+    <td align="right"><ins>2</ins></td>
+  </tr>
+  </tbody>
+  <caption class="caption" style="caption-side: bottom;">1 added, 1 removed, 1 changed</caption>
+</table>`)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		compareOutput(t, tw.RenderMarkdown(), `
+|  | Name | State | Replicas |
+| --- | --- | --- | ---:|
+| ~ | api | ready | 2 → 3 |
+| - | db | ready | 1 |
+|  | cache | ready | 1 |
+| + | web | <new> | 2 |
+_1 added, 1 removed, 1 changed_`)
+	})
+}
+
+func TestDiff_HTMLSafeCells(t *testing.T) {
+	before, after := testDiffTables()
+
+	// a plain cell with the same text as a diff cell should still be escaped
+	tw := Diff(before, after, "Name")
+	tw.AppendRow(Row{"", "<ins>web</ins>", "x", 0})
+	tw.SortBy([]SortBy{{Name: "Name", Mode: Dsc}})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "State", Hidden: true}})
+	compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>&nbsp;</th>
+    <th>Name</th>
+    <th align="right">Replicas</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>&nbsp;</td>
+    <td>cache</td>
+    <td align="right">1</td>
+  </tr>
+  <tr>
+    <td>~</td>
+    <td>api</td>
+    <td align="right"><del>2</del> → <ins>3</ins></td>
+  </tr>
+  <tr>
+    <td><ins>+</ins></td>
+    <td><ins>web</ins></td>
+    <td align="right"><ins>2</ins></td>
+  </tr>
+  <tr>
+    <td>&nbsp;</td>
+    <td>&lt;ins&gt;web&lt;/ins&gt;</td>
+    <td align="right">0</td>
+  </tr>
+  <tr>
+    <td><del>-</del></td>
+    <td><del>db</del></td>
+    <td align="right"><del>1</del></td>
+  </tr>
+  </tbody>
+  <caption class="caption" style="caption-side: bottom;">1 added, 1 removed, 1 changed</caption>
+</table>`)
+}
+
+func TestDiff_ColumnsAddedAndRemoved(t *testing.T) {
+	before := NewWriter()
+	before.AppendHeader(Row{"ID", "Size", "Zone"})
+	before.AppendRow(Row{1, 10, "a"})
+	after := NewWriter()
+	after.AppendHeader(Row{"ID", "Owner", "Size"})
+	after.AppendRow(Row{1, "ops", 20})
+
+	compareOutput(t, Diff(before, after, "ID").RenderCSV(), ""+
+		",ID,Owner,Size,Zone\n"+
+		"~,1, → ops,10 → 20,a → \n"+
+		"0 added, 0 removed, 1 changed")
+}
+
+func TestDiff_Empty(t *testing.T) {
+	tw := Diff(NewWriter(), NewWriter(), "ID")
+	assert.Equal(t, 0, tw.Length())
+	assert.Empty(t, tw.Render())
+}
+
+func TestDiff_KeyColumnNotFound(t *testing.T) {
+	before, after := testDiffTables()
+
+	tw := Diff(before, after, "Foo")
+	assert.Equal(t, 0, tw.Length())
+	compareOutput(t, tw.RenderCSV(), `
+,Name,State,Replicas
+unknown key column "Foo"`)
+}
+
+func TestDiff_NoHeader(t *testing.T) {
+	before := NewWriter()
+	before.AppendRows([]Row{{"a", 1}, {"b", 2}})
+	after := NewWriter()
+	after.AppendRows([]Row{{"b", 3}, {"c", 4}})
+
+	compareOutput(t, Diff(before, after, "A").RenderCSV(), `
+-,a,1
+~,b,2 → 3
++,c,4
+1 added, 1 removed, 1 changed`)
+}
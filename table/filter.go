@@ -94,6 +94,9 @@ func (t *Table) parseFilterBy(filterBy []FilterBy) []FilterBy {
 				}
 			}
 		}
+		if colNum == 0 && filter.Name != "" {
+			colNum = t.getVirtualColumnNumber(filter.Name)
+		}
 		if colNum > 0 {
 			resFilterBy = append(resFilterBy, FilterBy{
 				Name:          filter.Name,
@@ -125,7 +128,7 @@ func (t *Table) matchesFilterRaw(row Row, filter FilterBy) bool {
 		return false
 	}
 
-	cellValue := row[colIdx]
+	cellValue := cellValueOf(row[colIdx])
 	cellValueStr := fmt.Sprint(cellValue)
 
 	// Use custom filter if provided
@@ -0,0 +1,362 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// JoinKind defines the rows that make it to the table generated by Join.
+type JoinKind int
+
+const (
+	// JoinInner includes only the rows found in both the tables.
+	JoinInner JoinKind = iota
+	// JoinLeft includes all the rows in the left table, along with the
+	// matching rows in the right table.
+	JoinLeft
+	// JoinRight includes all the rows in the right table, along with the
+	// matching rows in the left table.
+	JoinRight
+	// JoinFull includes all the rows in both the tables (a full outer join).
+	JoinFull
+)
+
+// Join related constants
+const (
+	joinSuffixLeft  = "_left"
+	joinSuffixRight = "_right"
+)
+
+// JoinSpec defines the columns the tables are to be joined on using Join, and
+// the way the columns and cells are named/filled in the joined table.
+type JoinSpec struct {
+	// Columns are the names of the key columns as they appear in the first
+	// Header row of the left table, or as A, B, C, etc. without one. If a
+	// column cannot be found, the joined table has no rows, and the caption
+	// says so. Like NULLs in SQL, rows with a nil (or missing) key cell match
+	// no other row.
+	Columns []string
+	// ColumnsRight are the names of the key columns in the right table if
+	// they are named differently from Columns; should be of the same length.
+	ColumnsRight []string
+	// NullMarker is rendered in the cells of the rows with no match in the
+	// other table (ex.: "NULL"); default: ""
+	NullMarker string
+	// SuffixLeft and SuffixRight are appended to the names of the non-key
+	// columns found in both the tables; default: "_left" and "_right"
+	SuffixLeft  string
+	SuffixRight string
+}
+
+func (js JoinSpec) getColumnsRight() []string {
+	if len(js.ColumnsRight) > 0 {
+		return js.ColumnsRight
+	}
+	return js.Columns
+}
+
+func (js JoinSpec) getSuffixLeft() string {
+	if js.SuffixLeft != "" {
+		return js.SuffixLeft
+	}
+	return joinSuffixLeft
+}
+
+func (js JoinSpec) getSuffixRight() string {
+	if js.SuffixRight != "" {
+		return js.SuffixRight
+	}
+	return joinSuffixRight
+}
+
+// Join combines the rows of two tables having the same values in the key
+// columns (refer to JoinSpec) into a new Writer, like a SQL join. The joined
+// table has all the columns of the left table (with the key columns) followed
+// by the non-key columns of the right table, and:
+//   - the rows in the order of the left table, followed by the rows found only
+//     in the right table (for JoinRight and JoinFull)
+//   - a row for every pair of matching rows, if a key is repeated
+//   - the Header rows merged, with the names of the non-key columns found in
+//     both the tables suffixed with JoinSpec.SuffixLeft/SuffixRight
+//   - the column configs of both the tables (the left one wins for the key
+//     columns), minus Compute and ComputeFooter
+//   - the numeric columns aligned to the right in spite of the missing cells
+//
+// The rows are joined after filtering, with the computed columns filled in;
+// the Footer rows are not carried over. Example (JoinFull on "ID", with
+// "NULL" as the NullMarker):
+//
+//	+----+------+-----------+-------+------------+
+//	| ID | NAME | ZONE_LEFT | STOCK | ZONE_RIGHT |
+//	+----+------+-----------+-------+------------+
+//	|  1 | Bolt | a         |   120 | x          |
+//	|  2 | Nut  | b         |  NULL | NULL       |
+//	|  3 | NULL | NULL      |    40 | y          |
+//	+----+------+-----------+-------+------------+
+func Join(left Writer, right Writer, on JoinSpec, kind JoinKind) Writer {
+	tLeft, headerLeft := writerAsTable(left).cloneFiltered()
+	tRight, headerRight := writerAsTable(right).cloneFiltered()
+	rowsLeft, rowsRight := tLeft.rowsRawFiltered, tRight.rowsRawFiltered
+	columnsLeft := joinColumnNames(headerLeft, rowsLeft)
+	columnsRight := joinColumnNames(headerRight, rowsRight)
+	keysLeft, keysRight, err := joinKeyIndicesOfBoth(columnsLeft, columnsRight, on)
+
+	tw := &Table{}
+	if tLeft.style != nil {
+		tw.SetStyle(*tLeft.style)
+	}
+	tw.title = tLeft.title
+	columns := joinColumns(columnsLeft, columnsRight, keysLeft, keysRight, on)
+	if len(columns) > 0 && (headerLeft != nil || headerRight != nil) {
+		header := make(Row, len(columns))
+		for colIdx, column := range columns {
+			header[colIdx] = column.name
+		}
+		tw.AppendHeader(header)
+	}
+	// joining the rows by anything but the keys would make up the matches
+	if err != nil {
+		if len(columns) > 0 {
+			tw.SetCaption("%v", err)
+		}
+		return tw
+	}
+	// match every row in "left" to all the rows in "right" with the same key
+	rightIndices := make(map[string][]int)
+	for rowIdx, row := range rowsRight {
+		if key, ok := joinKey(row, keysRight); ok {
+			rightIndices[key] = append(rightIndices[key], rowIdx)
+		}
+	}
+	matchedRight := make([]bool, len(rowsRight))
+	for _, rowLeft := range rowsLeft {
+		var matches []int
+		if key, ok := joinKey(rowLeft, keysLeft); ok {
+			matches = rightIndices[key]
+		}
+		for _, rightIdx := range matches {
+			tw.AppendRow(joinRow(columns, rowLeft, rowsRight[rightIdx], on.NullMarker))
+			matchedRight[rightIdx] = true
+		}
+		if len(matches) == 0 && (kind == JoinLeft || kind == JoinFull) {
+			tw.AppendRow(joinRow(columns, rowLeft, nil, on.NullMarker))
+		}
+	}
+	if kind == JoinRight || kind == JoinFull {
+		for rowIdx, rowRight := range rowsRight {
+			if !matchedRight[rowIdx] {
+				tw.AppendRow(joinRow(columns, nil, rowRight, on.NullMarker))
+			}
+		}
+	}
+	tw.SetColumnConfigs(joinColumnConfigs(tLeft, tRight, columns, tw.rowsRaw))
+	return tw
+}
+
+// joinColumn is a column in the table generated by Join, along with the
+// indices of the columns it gets its values from (-1 if none).
+type joinColumn struct {
+	name     string
+	srcLeft  int
+	srcRight int
+}
+
+// joinNullCell is a cell with no value as the row had no match in the other
+// table.
+type joinNullCell struct {
+	marker string
+}
+
+func (j joinNullCell) cellValue() interface{} {
+	return nil
+}
+
+func (j joinNullCell) renderCell(t *Table, _ int, _ renderHint) string {
+	if t.renderMode == renderModeHTML {
+		return t.htmlEscape(j.marker)
+	}
+	return j.marker
+}
+
+// joinColumnConfigs returns the column configs of both the tables mapped to
+// the columns of the joined table.
+func joinColumnConfigs(tLeft *Table, tRight *Table, columns []joinColumn, rows []Row) []ColumnConfig {
+	var configs []ColumnConfig
+	for colIdx, column := range columns {
+		colCfg, ok := tLeft.columnConfigMap[column.srcLeft]
+		if !ok {
+			colCfg, ok = tRight.columnConfigMap[column.srcRight]
+		}
+		// the missing cells would make a numeric column non-numeric, and
+		// get it aligned to the left
+		if joinColumnIsNumeric(rows, colIdx) {
+			if colCfg.Align == text.AlignDefault {
+				colCfg.Align = text.AlignRight
+			}
+			if colCfg.AlignHeader == text.AlignDefault {
+				colCfg.AlignHeader = text.AlignRight
+			}
+			ok = true
+		}
+		if !ok {
+			continue
+		}
+		// the computed values are already in the rows, and the footers are
+		// not carried over
+		colCfg.Name, colCfg.Number = column.name, colIdx+1
+		colCfg.Compute, colCfg.ComputeFooter = nil, nil
+		configs = append(configs, colCfg)
+	}
+	return configs
+}
+
+// joinColumnIsNumeric returns true if the column has nothing but numbers,
+// barring the missing cells.
+func joinColumnIsNumeric(rows []Row, colIdx int) bool {
+	hasNumbers := false
+	for _, row := range rows {
+		if _, isNull := row[colIdx].(joinNullCell); isNull {
+			continue
+		} else if !isNumber(cellValueOf(row[colIdx])) {
+			return false
+		}
+		hasNumbers = true
+	}
+	return hasNumbers
+}
+
+// joinColumnNames returns the names of the columns in the table as they appear
+// in the header, or as A, B, C, etc. if there is none.
+func joinColumnNames(header Row, rows []Row) []string {
+	numColumns := len(header)
+	for _, row := range rows {
+		if len(row) > numColumns {
+			numColumns = len(row)
+		}
+	}
+	columns := make([]string, numColumns)
+	for colIdx := range columns {
+		if header != nil {
+			if colIdx < len(header) {
+				columns[colIdx] = fmt.Sprint(header[colIdx])
+			}
+		} else {
+			columns[colIdx] = AutoIndexColumnID(colIdx)
+		}
+	}
+	return columns
+}
+
+// joinColumns returns the columns of the joined table: the ones in the left
+// table followed by the non-key columns in the right table.
+func joinColumns(columnsLeft []string, columnsRight []string, keysLeft []int, keysRight []int, on JoinSpec) []joinColumn {
+	isKeyRight := make(map[int]bool)
+	for _, colIdx := range keysRight {
+		isKeyRight[colIdx] = true
+	}
+	namesRight := make(map[string]bool)
+	for colIdx, name := range columnsRight {
+		if !isKeyRight[colIdx] {
+			namesRight[name] = true
+		}
+	}
+
+	var columns []joinColumn
+	namesLeft := make(map[string]bool)
+	for colIdx, name := range columnsLeft {
+		column := joinColumn{name: name, srcLeft: colIdx, srcRight: -1}
+		for keyIdx, keyColIdx := range keysLeft {
+			if keyColIdx == colIdx {
+				column.srcRight = keysRight[keyIdx]
+			}
+		}
+		if column.srcRight < 0 && namesRight[name] {
+			column.name += on.getSuffixLeft()
+		}
+		namesLeft[name] = true
+		columns = append(columns, column)
+	}
+	for colIdx, name := range columnsRight {
+		if isKeyRight[colIdx] {
+			continue
+		}
+		column := joinColumn{name: name, srcLeft: -1, srcRight: colIdx}
+		if namesLeft[name] {
+			column.name += on.getSuffixRight()
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// joinKey returns the key to match the row on, or false if any of the key
+// cells is nil (or missing), as such rows match nothing, like NULLs in SQL.
+func joinKey(row Row, keyIndices []int) (string, bool) {
+	key := make([]string, len(keyIndices))
+	for idx, colIdx := range keyIndices {
+		if colIdx >= len(row) || cellValueOf(row[colIdx]) == nil {
+			return "", false
+		}
+		key[idx] = diffValueString(cellValueOf(row[colIdx]))
+	}
+	return strings.Join(key, "\x00"), true
+}
+
+// joinKeyIndices returns the indices of the key columns, or the name of the
+// first one that cannot be found.
+func joinKeyIndices(columns []string, keyColumns []string) ([]int, string) {
+	keyIndices := make([]int, len(keyColumns))
+	for idx, keyColumn := range keyColumns {
+		keyIndices[idx] = -1
+		for colIdx, column := range columns {
+			if column == keyColumn {
+				keyIndices[idx] = colIdx
+				break
+			}
+		}
+		if keyIndices[idx] < 0 {
+			return nil, keyColumn
+		}
+	}
+	return keyIndices, ""
+}
+
+// joinKeyIndicesOfBoth returns the indices of the key columns in the left and
+// the right tables, or an error describing why they cannot be used.
+func joinKeyIndicesOfBoth(columnsLeft []string, columnsRight []string, on JoinSpec) ([]int, []int, error) {
+	if len(on.Columns) == 0 {
+		return nil, nil, fmt.Errorf("no key columns to join on")
+	}
+	if len(on.getColumnsRight()) != len(on.Columns) {
+		return nil, nil, fmt.Errorf("%d key columns in JoinSpec.ColumnsRight instead of %d",
+			len(on.getColumnsRight()), len(on.Columns))
+	}
+	keysLeft, unknown := joinKeyIndices(columnsLeft, on.Columns)
+	if unknown != "" {
+		return nil, nil, fmt.Errorf("unknown key column %q", unknown)
+	}
+	keysRight, unknown := joinKeyIndices(columnsRight, on.getColumnsRight())
+	if unknown != "" {
+		return nil, nil, fmt.Errorf("unknown key column %q in the right table", unknown)
+	}
+	return keysLeft, keysRight, nil
+}
+
+// joinRow generates the row to render from the matching rows of the two
+// tables; either of which may be nil when there is no match.
+func joinRow(columns []joinColumn, rowLeft Row, rowRight Row, nullMarker string) Row {
+	row := make(Row, len(columns))
+	for colIdx, column := range columns {
+		switch {
+		case rowLeft != nil && column.srcLeft >= 0 && column.srcLeft < len(rowLeft):
+			row[colIdx] = rowLeft[column.srcLeft]
+		case rowRight != nil && column.srcRight >= 0 && column.srcRight < len(rowRight):
+			row[colIdx] = rowRight[column.srcRight]
+		default:
+			row[colIdx] = joinNullCell{marker: nullMarker}
+		}
+	}
+	return row
+}
@@ -0,0 +1,244 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func testJoinTables() (Writer, Writer) {
+	left := NewWriter()
+	left.AppendHeader(Row{"ID", "Name", "Zone"})
+	left.AppendRows([]Row{
+		{1, "Bolt", "a"},
+		{2, "Nut", "b"},
+		{4, "Washer", "c"},
+	})
+
+	right := NewWriter()
+	right.AppendHeader(Row{"ID", "Stock", "Zone"})
+	right.AppendRows([]Row{
+		{1, 120, "x"},
+		{3, 40, "y"},
+		{1, 5, "z"},
+		{4, 0, "c"},
+	})
+	return left, right
+}
+
+func TestJoin(t *testing.T) {
+	left, right := testJoinTables()
+	on := JoinSpec{Columns: []string{"ID"}, NullMarker: "NULL"}
+
+	t.Run("inner", func(t *testing.T) {
+		compareOutput(t, Join(left, right, on, JoinInner).Render(), `
++----+--------+-----------+-------+------------+
+| ID | NAME   | ZONE_LEFT | STOCK | ZONE_RIGHT |
++----+--------+-----------+-------+------------+
+|  1 | Bolt   | a         |   120 | x          |
+|  1 | Bolt   | a         |     5 | z          |
+|  4 | Washer | c         |     0 | c          |
++----+--------+-----------+-------+------------+`)
+	})
+
+	t.Run("left", func(t *testing.T) {
+		compareOutput(t, Join(left, right, on, JoinLeft).Render(), `
++----+--------+-----------+-------+------------+
+| ID | NAME   | ZONE_LEFT | STOCK | ZONE_RIGHT |
++----+--------+-----------+-------+------------+
+|  1 | Bolt   | a         |   120 | x          |
+|  1 | Bolt   | a         |     5 | z          |
+|  2 | Nut    | b         |  NULL | NULL       |
+|  4 | Washer | c         |     0 | c          |
++----+--------+-----------+-------+------------+`)
+	})
+
+	t.Run("right", func(t *testing.T) {
+		compareOutput(t, Join(left, right, on, JoinRight).Render(), `
++----+--------+-----------+-------+------------+
+| ID | NAME   | ZONE_LEFT | STOCK | ZONE_RIGHT |
++----+--------+-----------+-------+------------+
+|  1 | Bolt   | a         |   120 | x          |
+|  1 | Bolt   | a         |     5 | z          |
+|  4 | Washer | c         |     0 | c          |
+|  3 | NULL   | NULL      |    40 | y          |
++----+--------+-----------+-------+------------+`)
+	})
+
+	t.Run("full", func(t *testing.T) {
+		compareOutput(t, Join(left, right, on, JoinFull).Render(), `
++----+--------+-----------+-------+------------+
+| ID | NAME   | ZONE_LEFT | STOCK | ZONE_RIGHT |
++----+--------+-----------+-------+------------+
+|  1 | Bolt   | a         |   120 | x          |
+|  1 | Bolt   | a         |     5 | z          |
+|  2 | Nut    | b         |  NULL | NULL       |
+|  4 | Washer | c         |     0 | c          |
+|  3 | NULL   | NULL      |    40 | y          |
++----+--------+-----------+-------+------------+`)
+	})
+
+	t.Run("multiple keys and suffixes", func(t *testing.T) {
+		on := JoinSpec{Columns: []string{"ID", "Zone"}, SuffixLeft: " (L)", SuffixRight: " (R)"}
+		compareOutput(t, Join(left, right, on, JoinFull).RenderCSV(), `
+ID,Name,Zone,Stock
+1,Bolt,a,
+2,Nut,b,
+4,Washer,c,0
+1,,x,120
+3,,y,40
+1,,z,5`)
+
+		right := NewWriter()
+		right.AppendHeader(Row{"ID", "Name"})
+		right.AppendRows([]Row{{4, "Washer (M4)"}, {5, "Screw"}})
+		on.Columns = []string{"ID"}
+		compareOutput(t, Join(left, right, on, JoinInner).RenderCSV(), `
+ID,Name (L),Zone,Name (R)
+4,Washer,c,Washer (M4)`)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		left, right := testJoinTables()
+		on := JoinSpec{Columns: []string{"ID"}, NullMarker: "<none>"}
+		compareOutput(t, Join(left, right, on, JoinRight).RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th align="right">ID</th>
+    <th>Name</th>
+    <th>Zone_left</th>
+    <th align="right">Stock</th>
+    <th>Zone_right</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td align="right">1</td>
+    <td>Bolt</td>
+    <td>a</td>
+    <td align="right">120</td>
+    <td>x</td>
+  </tr>
+  <tr>
+    <td align="right">1</td>
+    <td>Bolt</td>
+    <td>a</td>
+    <td align="right">5</td>
+    <td>z</td>
+  </tr>
+  <tr>
+    <td align="right">4</td>
+    <td>Washer</td>
+    <td>c</td>
+    <td align="right">0</td>
+    <td>c</td>
+  </tr>
+  <tr>
+    <td align="right">3</td>
+    <td>&lt;none&gt;</td>
+    <td>&lt;none&gt;</td>
+    <td align="right">40</td>
+    <td>y</td>
+  </tr>
+  </tbody>
+</table>`)
+	})
+}
+
+func TestJoin_ColumnConfigs(t *testing.T) {
+	left, right := testJoinTables()
+	left.SetColumnConfigs([]ColumnConfig{
+		{Name: "Name", Transformer: text.Transformer(func(val interface{}) string {
+			return "<" + val.(string) + ">"
+		})},
+		{Name: "Zone", Hidden: true},
+	})
+	right.SetColumnConfigs([]ColumnConfig{
+		{Number: 3, Hidden: true},
+		{Name: "Stock", Align: text.AlignCenter},
+		{Name: "Value", Compute: func(row Row) interface{} {
+			return row[1].(int) * 10
+		}},
+	})
+	right.FilterBy([]FilterBy{{Name: "Stock", Operator: GreaterThan, Value: 0}})
+
+	tw := Join(left, right, JoinSpec{Columns: []string{"ID"}}, JoinFull)
+	compareOutput(t, tw.Render(), `
++----+----------+-------+-------+
+| ID | NAME     | STOCK | VALUE |
++----+----------+-------+-------+
+|  1 | <Bolt>   |  120  |  1200 |
+|  1 | <Bolt>   |   5   |    50 |
+|  2 | <Nut>    |       |       |
+|  4 | <Washer> |       |       |
+|  3 |          |   40  |   400 |
++----+----------+-------+-------+`)
+}
+
+func TestJoin_Empty(t *testing.T) {
+	tw := Join(NewWriter(), NewWriter(), JoinSpec{}, JoinFull)
+	assert.Equal(t, 0, tw.Length())
+	assert.Empty(t, tw.Render())
+}
+
+func TestJoin_KeyColumnNotFound(t *testing.T) {
+	left, right := testJoinTables()
+
+	tw := Join(left, right, JoinSpec{Columns: []string{"Foo"}}, JoinLeft)
+	assert.Equal(t, 0, tw.Length())
+	compareOutput(t, tw.RenderCSV(), `
+ID_left,Name,Zone_left,ID_right,Stock,Zone_right
+unknown key column "Foo"`)
+
+	tw = Join(left, right, JoinSpec{Columns: []string{"ID"}, ColumnsRight: []string{"Id"}}, JoinLeft)
+	assert.Equal(t, 0, tw.Length())
+	compareOutput(t, tw.RenderCSV(), `
+ID_left,Name,Zone_left,ID_right,Stock,Zone_right
+unknown key column "Id" in the right table`)
+
+	tw = Join(left, right, JoinSpec{Columns: []string{"ID", "Zone"}, ColumnsRight: []string{"ID"}}, JoinLeft)
+	compareOutput(t, tw.RenderCSV(), `
+ID_left,Name,Zone_left,ID_right,Stock,Zone_right
+1 key columns in JoinSpec.ColumnsRight instead of 2`)
+
+	tw = Join(left, right, JoinSpec{}, JoinLeft)
+	compareOutput(t, tw.RenderCSV(), `
+ID_left,Name,Zone_left,ID_right,Stock,Zone_right
+no key columns to join on`)
+}
+
+func TestJoin_NilKeys(t *testing.T) {
+	left := NewWriter()
+	left.AppendHeader(Row{"ID", "Name"})
+	left.AppendRows([]Row{{1, "Bolt"}, {nil, "Nut"}, {"", "Washer"}, {2}})
+	right := NewWriter()
+	right.AppendHeader(Row{"ID", "Stock"})
+	right.AppendRows([]Row{{1, 120}, {nil, 40}, {"", 5}})
+
+	// the rows with nil keys match nothing, not even each other, or ""
+	compareOutput(t, Join(left, right, JoinSpec{Columns: []string{"ID"}, NullMarker: "NULL"}, JoinFull).RenderCSV(), `
+ID,Name,Stock
+1,Bolt,120
+<nil>,Nut,NULL
+,Washer,5
+2,NULL,NULL
+<nil>,NULL,40`)
+	compareOutput(t, Join(left, right, JoinSpec{Columns: []string{"ID"}}, JoinInner).RenderCSV(), `
+ID,Name,Stock
+1,Bolt,120
+,Washer,5`)
+}
+
+func TestJoin_NoHeader(t *testing.T) {
+	left := NewWriter()
+	left.AppendRows([]Row{{"a", 1}, {"b", 2}})
+	right := NewSyncWriter()
+	right.AppendRows([]Row{{"b", 3}, {"c", 4}})
+
+	compareOutput(t, Join(left, right, JoinSpec{Columns: []string{"A"}}, JoinFull).RenderCSV(), `
+a,1,
+b,2,3
+c,,4`)
+}
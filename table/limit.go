@@ -0,0 +1,98 @@
+package table
+
+import (
+	"github.com/jedib0t/go-pretty/v6/text"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// getRowsOmitted returns the number of rows omitted above the given row (or
+// below the last row if the index is len(rows)), if any.
+func (t *Table) getRowsOmitted(rowIdx int, hint renderHint) int {
+	if !hint.isRegularRow() || t.rowsOmitted == 0 || rowIdx != t.rowsOmittedIdx {
+		return 0
+	}
+	return t.rowsOmitted
+}
+
+// getRowsOmittedText returns the text to render in place of the omitted rows.
+func (t *Table) getRowsOmittedText(numRows int) string {
+	if numRows == 1 {
+		return "… 1 row omitted …"
+	}
+	return message.NewPrinter(language.English).Sprintf("… %d rows omitted …", numRows)
+}
+
+// getRowsOmittedWidth returns the length of the row rendered in place of the
+// omitted rows (with the padding and the borders), if any.
+func (t *Table) getRowsOmittedWidth() int {
+	if t.rowsOmitted == 0 {
+		return 0
+	}
+	width := text.StringWidthWithoutEscSequences(t.getRowsOmittedText(t.rowsOmitted) +
+		t.style.Box.PaddingLeft + t.style.Box.PaddingRight)
+	if t.style.Options.DrawBorder {
+		width += text.StringWidthWithoutEscSequences(t.style.Box.Left + t.style.Box.Right)
+	}
+	return width
+}
+
+// getRowsVisibleRaw returns the raw rows being rendered, in order.
+func (t *Table) getRowsVisibleRaw() []Row {
+	rows := make([]Row, 0, len(t.rows))
+	for rowIdx := range t.rows {
+		if t.sortedRowIndices != nil {
+			rowIdx = t.sortedRowIndices[rowIdx]
+		}
+		rows = append(rows, t.rowsRawFiltered[rowIdx])
+	}
+	return rows
+}
+
+// initForRenderLimitRows skips the rows as per Offset, and leaves out the ones
+// in the middle as per Limit, keeping the sorted row indices and the
+// separators in sync.
+func (t *Table) initForRenderLimitRows() {
+	t.separatorsRendered = t.separators
+	if t.rowsOffset == 0 && t.rowsLimitHead == 0 && t.rowsLimitTail == 0 {
+		return
+	}
+
+	rows, rowIndices := t.rows, t.sortedRowIndices
+	if rowIndices == nil {
+		rowIndices = make([]int, len(rows))
+		for idx := range rowIndices {
+			rowIndices[idx] = idx
+		}
+	}
+	// positions tracks where each row kept was before this
+	positions := make([]int, len(rows))
+	for idx := range positions {
+		positions[idx] = idx
+	}
+	if offset := t.rowsOffset; offset > 0 {
+		if offset > len(rows) {
+			offset = len(rows)
+		}
+		rows, rowIndices, positions = rows[offset:], rowIndices[offset:], positions[offset:]
+	}
+
+	head, tail := t.rowsLimitHead, t.rowsLimitTail
+	if (head > 0 || tail > 0) && head+tail < len(rows) {
+		t.rowsOmitted = len(rows) - head - tail
+		t.rowsOmittedIdx = head
+		rows = append(append([]rowStr{}, rows[:head]...), rows[len(rows)-tail:]...)
+		rowIndices = append(append([]int{}, rowIndices[:head]...), rowIndices[len(rowIndices)-tail:]...)
+		positions = append(append([]int{}, positions[:head]...), positions[len(positions)-tail:]...)
+	}
+	t.rows, t.sortedRowIndices = rows, rowIndices
+
+	if len(t.separators) > 0 {
+		t.separatorsRendered = make(map[int]bool)
+		for rowIdx, position := range positions {
+			if t.separators[position] {
+				t.separatorsRendered[rowIdx] = true
+			}
+		}
+	}
+}
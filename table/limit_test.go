@@ -0,0 +1,219 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTableWithManyRows() Writer {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name", "Amount"})
+	for idx := 1; idx <= 20; idx++ {
+		tw.AppendRow(Row{idx, fmt.Sprintf("item-%02d", idx), idx * 100})
+	}
+	tw.AppendFooter(Row{"", "Total"})
+	tw.SetStyle(StyleLight)
+	return tw
+}
+
+func sumOfAmounts(rows []Row) interface{} {
+	sum := 0
+	for _, row := range rows {
+		sum += row[2].(int)
+	}
+	return sum
+}
+
+func TestTable_Limit(t *testing.T) {
+	t.Run("head and tail", func(t *testing.T) {
+		tw := generateTableWithManyRows()
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Amount", ComputeFooter: sumOfAmounts}})
+		tw.Limit(3, 2)
+
+		compareOutput(t, tw.Render(), `
+┌────┬─────────┬────────┐
+│  # │ NAME    │ AMOUNT │
+├────┼─────────┼────────┤
+│  1 │ item-01 │    100 │
+│  2 │ item-02 │    200 │
+│  3 │ item-03 │    300 │
+│  … 15 rows omitted …  │
+│ 19 │ item-19 │   1900 │
+│ 20 │ item-20 │   2000 │
+├────┼─────────┼────────┤
+│    │ TOTAL   │  21000 │
+└────┴─────────┴────────┘`)
+		assert.Equal(t, 20, tw.Length())
+
+		tw.Limit(0, 0)
+		assert.Equal(t, 20+6, len(strings.Split(tw.Render(), "\n")))
+	})
+
+	t.Run("head only, sorted, with offset", func(t *testing.T) {
+		tw := generateTableWithManyRows()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Amount", ComputeFooter: sumOfAmounts, ComputeFooterFromVisibleRows: true},
+		})
+		tw.SortBy([]SortBy{{Name: "Amount", Mode: DscNumeric}})
+		tw.Offset(2)
+		tw.Limit(2, 0)
+
+		compareOutput(t, tw.Render(), `
+┌────┬─────────┬────────┐
+│  # │ NAME    │ AMOUNT │
+├────┼─────────┼────────┤
+│ 18 │ item-18 │   1800 │
+│ 17 │ item-17 │   1700 │
+│  … 16 rows omitted …  │
+├────┼─────────┼────────┤
+│    │ TOTAL   │   3500 │
+└────┴─────────┴────────┘`)
+	})
+
+	t.Run("tail only, filtered, colored", func(t *testing.T) {
+		tw := generateTableWithManyRows()
+		tw.FilterBy([]FilterBy{{Name: "Amount", Operator: GreaterThan, Value: 1000}})
+		tw.Limit(0, 1)
+		tw.Style().Color.RowsOmitted = text.Colors{text.Italic}
+		tw.Style().Options.DrawBorder = false
+
+		compareOutputColored(t, tw.Render(), ""+
+			"  # │ NAME    │ AMOUNT \n"+
+			"────┼─────────┼────────\n"+
+			"\x1b[3m   … 9 rows omitted …  \x1b[0m\n"+
+			" 20 │ item-20 │   2000 \n"+
+			"────┼─────────┼────────\n"+
+			"    │ TOTAL   │        ")
+	})
+
+	t.Run("offset only", func(t *testing.T) {
+		tw := generateTableWithManyRows()
+		tw.Offset(18)
+
+		compareOutput(t, tw.Render(), `
+┌────┬─────────┬────────┐
+│  # │ NAME    │ AMOUNT │
+├────┼─────────┼────────┤
+│ 19 │ item-19 │   1900 │
+│ 20 │ item-20 │   2000 │
+├────┼─────────┼────────┤
+│    │ TOTAL   │        │
+└────┴─────────┴────────┘`)
+
+		tw.Offset(100)
+		compareOutput(t, tw.Render(), `
+┌───┬───────┬────────┐
+│ # │ NAME  │ AMOUNT │
+├───┼───────┼────────┤
+│   │ TOTAL │        │
+└───┴───────┴────────┘`)
+	})
+
+	t.Run("separators", func(t *testing.T) {
+		tw := NewWriter()
+		tw.SetStyle(StyleLight)
+		for idx := 1; idx <= 10; idx++ {
+			tw.AppendRow(Row{idx, "a long enough value"})
+			if idx == 2 || idx == 9 {
+				tw.AppendSeparator()
+			}
+		}
+		tw.Limit(2, 2)
+
+		compareOutput(t, tw.Render(), `
+┌────┬─────────────────────┐
+│  1 │ a long enough value │
+│  2 │ a long enough value │
+├────┼─────────────────────┤
+│    … 6 rows omitted …    │
+│  9 │ a long enough value │
+├────┼─────────────────────┤
+│ 10 │ a long enough value │
+└────┴─────────────────────┘`)
+
+		tw.Offset(1)
+		compareOutput(t, tw.Render(), `
+┌────┬─────────────────────┐
+│  2 │ a long enough value │
+├────┼─────────────────────┤
+│  3 │ a long enough value │
+│    … 5 rows omitted …    │
+│  9 │ a long enough value │
+├────┼─────────────────────┤
+│ 10 │ a long enough value │
+└────┴─────────────────────┘`)
+	})
+
+	t.Run("narrow table", func(t *testing.T) {
+		tw := NewWriter()
+		tw.SetStyle(StyleLight)
+		for idx := 1; idx <= 10; idx++ {
+			tw.AppendRow(Row{idx, "x"})
+		}
+		tw.Limit(2, 2)
+
+		compareOutput(t, tw.Render(), `
+┌──────────┬─────────┐
+│        1 │ x       │
+│        2 │ x       │
+│ … 6 rows omitted … │
+│        9 │ x       │
+│       10 │ x       │
+└──────────┴─────────┘`)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		tw := generateTableWithManyRows()
+		tw.ResetFooters()
+		tw.Limit(1, 0)
+
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th align="right">#</th>
+    <th>Name</th>
+    <th align="right">Amount</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td align="right">1</td>
+    <td>item-01</td>
+    <td align="right">100</td>
+  </tr>
+  <tr>
+    <td align="center" colspan=3>… 19 rows omitted …</td>
+  </tr>
+  </tbody>
+</table>`)
+	})
+
+	t.Run("markdown and csv", func(t *testing.T) {
+		tw := generateTableWithManyRows()
+		tw.Limit(1, 1)
+
+		compareOutput(t, tw.RenderMarkdown(), `
+| # | Name | Amount |
+| ---:| --- | ---:|
+| 1 | item-01 | 100 |
+| _… 18 rows omitted …_ |  |  |
+| 20 | item-20 | 2000 |
+|  | Total |  |`)
+		compareOutput(t, tw.RenderCSV(), `
+#,Name,Amount
+1,item-01,100
+20,item-20,2000
+,Total,`)
+	})
+}
+
+func TestTable_getRowsOmittedText(t *testing.T) {
+	tw := Table{}
+	assert.Equal(t, "… 1 row omitted …", tw.getRowsOmittedText(1))
+	assert.Equal(t, "… 12,345 rows omitted …", tw.getRowsOmittedText(12345))
+}
@@ -0,0 +1,92 @@
+package table
+
+import (
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// Link is a cell value that renders as a hyperlink in the format being
+// rendered:
+//   - an OSC 8 hyperlink (refer to text.Hyperlink) in Render/RenderVertical,
+//     for the terminals that support them
+//   - an <a href="..."> tag in RenderHTML
+//   - a [Text](URL) link in RenderMarkdown
+//   - just the URL in RenderCSV/RenderTSV
+//
+// The Text is what the rows are filtered on, and the URL is used in place of it
+// if empty. The column's Transformer is not applied to it.
+//
+// Only the relative URLs, and the ones using the http, https and mailto
+// schemes are rendered as links; the rest (ex.: "javascript:...") could run
+// something when clicked, and are rendered as plain text.
+// Example:
+//
+//	tw.AppendRow(table.Row{1, table.Link{Text: "go-pretty", URL: "https://github.com/jedib0t/go-pretty"}})
+type Link struct {
+	Text string
+	URL  string
+}
+
+func (l Link) cellValue() interface{} {
+	return l.getText()
+}
+
+func (l Link) renderCell(t *Table, _ int, _ renderHint) string {
+	if !l.hasSafeURL() {
+		if t.renderMode == renderModeHTML {
+			return t.htmlEscape(l.getText())
+		}
+		return l.getText()
+	}
+
+	switch t.renderMode {
+	case renderModeCSV, renderModeTSV:
+		return l.URL
+	case renderModeHTML:
+		return "<a href=\"" + html.EscapeString(l.URL) + "\">" + t.htmlEscape(l.getText()) + "</a>"
+	case renderModeMarkdown:
+		return "[" + linkMarkdownTextReplacer.Replace(l.getText()) + "](" + linkMarkdownURLReplacer.Replace(l.URL) + ")"
+	default:
+		// every line is a link of its own, so that the borders and the padding
+		// rendered around the lines are not a part of it
+		lines := strings.Split(l.getText(), "\n")
+		for idx, line := range lines {
+			if line != "" {
+				lines[idx] = text.Hyperlink(l.URL, line)
+			}
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+// hasSafeURL returns true if the URL is relative, or uses one of the schemes
+// that can be opened without running anything.
+func (l Link) hasSafeURL() bool {
+	if l.URL == "" {
+		return false
+	}
+	// url.Parse rejects the control characters that browsers ignore in the
+	// scheme (ex.: "java\tscript:")
+	u, err := url.Parse(l.URL)
+	if err != nil {
+		return false
+	}
+	return linkSafeSchemes[u.Scheme]
+}
+
+func (l Link) getText() string {
+	if l.Text != "" {
+		return l.Text
+	}
+	return l.URL
+}
+
+// Link related variables
+var (
+	linkMarkdownTextReplacer = strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`)
+	linkMarkdownURLReplacer  = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29")
+	linkSafeSchemes          = map[string]bool{"": true, "http": true, "https": true, "mailto": true}
+)
@@ -0,0 +1,150 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testLinkTable() Writer {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Project", "Notes"})
+	tw.AppendRows([]Row{
+		{1, Link{Text: "go-pretty [v6]", URL: "https://github.com/jedib0t/go-pretty"}, "tables"},
+		{2, Link{URL: "https://example.com/a?b=1&c=2"}, "a|b"},
+		{3, Link{Text: "no <link>"}, ""},
+	})
+	return tw
+}
+
+func TestLink(t *testing.T) {
+	tw := testLinkTable()
+	compareOutputColored(t, tw.Render(), ""+
+		"+---+-------------------------------+--------+\n"+
+		"| # | PROJECT                       | NOTES  |\n"+
+		"+---+-------------------------------+--------+\n"+
+		"| 1 | \x1b]8;;https://github.com/jedib0t/go-pretty\x1b\\go-pretty [v6]\x1b]8;;\x1b\\                | tables |\n"+
+		"| 2 | \x1b]8;;https://example.com/a?b=1&c=2\x1b\\https://example.com/a?b=1&c=2\x1b]8;;\x1b\\ | a|b    |\n"+
+		"| 3 | no <link>                     |        |\n"+
+		"+---+-------------------------------+--------+")
+
+	t.Run("csv", func(t *testing.T) {
+		compareOutput(t, tw.RenderCSV(), `
+#,Project,Notes
+1,https://github.com/jedib0t/go-pretty,tables
+2,https://example.com/a?b=1&c=2,a|b
+3,no <link>,`)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th align="right">#</th>
+    <th>Project</th>
+    <th>Notes</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td align="right">1</td>
+    <td><a href="https://github.com/jedib0t/go-pretty">go-pretty [v6]</a></td>
+    <td>tables</td>
+  </tr>
+  <tr>
+    <td align="right">2</td>
+    <td><a href="https://example.com/a?b=1&amp;c=2">https://example.com/a?b=1&amp;c=2</a></td>
+    <td>a|b</td>
+  </tr>
+  <tr>
+    <td align="right">3</td>
+    <td>no &lt;link&gt;</td>
+    <td>&nbsp;</td>
+  </tr>
+  </tbody>
+</table>`)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendRow(Row{Link{Text: "go-pretty [v6]", URL: "https://example.com/a b(c)"}})
+		compareOutput(t, tw.RenderMarkdown(), `
+| [go-pretty \[v6\]](https://example.com/a%20b%28c%29) |`)
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendRow(Row{Link{Text: "go pretty", URL: "https://x.y"}})
+		tw.SetColumnConfigs([]ColumnConfig{{Number: 1, WidthMax: 6}})
+		compareOutputColored(t, tw.Render(), ""+
+			"+--------+\n"+
+			"| \x1b]8;;https://x.y\x1b\\go pre\x1b]8;;\x1b\\ |\n"+
+			"| \x1b]8;;https://x.y\x1b\\tty\x1b]8;;\x1b\\    |\n"+
+			"+--------+")
+	})
+
+	t.Run("filtered by text", func(t *testing.T) {
+		tw := testLinkTable()
+		tw.FilterBy([]FilterBy{{Name: "Project", Operator: Contains, Value: "[v6]"}})
+		compareOutput(t, tw.RenderTSV(), ""+
+			"#\tProject\tNotes\n"+
+			"1\thttps://github.com/jedib0t/go-pretty\ttables")
+	})
+}
+
+func TestLink_UnsafeURL(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendRow(Row{Link{Text: "click <me>", URL: "javascript:alert(1)"}})
+	tw.AppendRow(Row{Link{Text: "tab", URL: "java\tscript:alert(1)"}})
+	tw.AppendRow(Row{Link{Text: "spaced", URL: " JavaScript:alert(1)"}})
+	tw.AppendRow(Row{Link{URL: "data:text/html,<b>x</b>"}})
+	tw.AppendRow(Row{Link{Text: "mail", URL: "mailto:a@b.c"}})
+	tw.AppendRow(Row{Link{Text: "relative", URL: "/docs?a=1"}})
+
+	compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <tbody>
+  <tr>
+    <td>click &lt;me&gt;</td>
+  </tr>
+  <tr>
+    <td>tab</td>
+  </tr>
+  <tr>
+    <td>spaced</td>
+  </tr>
+  <tr>
+    <td>data:text/html,&lt;b&gt;x&lt;/b&gt;</td>
+  </tr>
+  <tr>
+    <td><a href="mailto:a@b.c">mail</a></td>
+  </tr>
+  <tr>
+    <td><a href="/docs?a=1">relative</a></td>
+  </tr>
+  </tbody>
+</table>`)
+	compareOutput(t, tw.RenderMarkdown(), `
+| click <me> |
+| tab |
+| spaced |
+| data:text/html,<b>x</b> |
+| [mail](mailto:a@b.c) |
+| [relative](/docs?a=1) |`)
+	compareOutput(t, tw.RenderCSV(), `
+click <me>
+tab
+spaced
+"data:text/html,<b>x</b>"
+mailto:a@b.c
+/docs?a=1`)
+	assert.Equal(t, 2*2, strings.Count(tw.Render(), "\x1b]8;"), "only the mail and relative links")
+}
+
+func TestLink_cellValue(t *testing.T) {
+	assert.Equal(t, "text", Link{Text: "text", URL: "url"}.cellValue())
+	assert.Equal(t, "url", Link{URL: "url"}.cellValue())
+	assert.Equal(t, "", Link{}.cellValue())
+}
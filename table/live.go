@@ -0,0 +1,290 @@
+package table
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"golang.org/x/term"
+)
+
+var (
+	// DefaultLiveHighlightColors defines the colors used by a LiveWriter to
+	// highlight the cells that changed since the previous frame.
+	DefaultLiveHighlightColors = text.Colors{text.BgYellow, text.FgBlack}
+
+	// DefaultLiveHighlightDuration defines for how long a LiveWriter keeps
+	// highlighting a cell after its value changed.
+	DefaultLiveHighlightDuration = time.Second * 2
+
+	// DefaultLiveRefresh defines a sane value for the frequency with which a
+	// LiveWriter re-renders the table.
+	DefaultLiveRefresh = time.Second
+)
+
+// cellPosition identifies a cell in the (stringified) rows being rendered.
+type cellPosition struct {
+	rowIdx int
+	colIdx int
+}
+
+// LiveWriter renders a table over and over at a fixed frequency, redrawing it
+// in place (like "kubectl get -w" or "watch"), and highlights the cells whose
+// values changed since the previous frame for a little while.
+//
+// The cells are compared by their position in the rendered table, after the
+// filtering and sorting. When the output is not a terminal, the cursor cannot
+// be moved around, and so every frame that differs from the previous one gets
+// appended to the output instead, without any highlighting.
+type LiveWriter struct {
+	cellsChangedAt        map[cellPosition]time.Time
+	frameLineWidths       []int
+	framePrev             string
+	highlightColors       text.Colors
+	highlightDuration     time.Duration
+	isTerminal            bool
+	mutex                 sync.Mutex
+	out                   io.Writer
+	refresh               time.Duration
+	renderContextCancel   context.CancelFunc
+	renderInProgress      bool
+	rowsPrev              []rowStr
+	terminalWidthOverride int
+	writer                Writer
+}
+
+// NewLiveWriter initializes and returns a LiveWriter that renders to the given
+// io.Writer (os.Stdout if nil) every "refresh" duration (DefaultLiveRefresh
+// if not positive).
+func NewLiveWriter(out io.Writer, refresh time.Duration) *LiveWriter {
+	if out == nil {
+		out = os.Stdout
+	}
+	if refresh <= 0 {
+		refresh = DefaultLiveRefresh
+	}
+
+	lw := &LiveWriter{
+		highlightColors:   DefaultLiveHighlightColors,
+		highlightDuration: DefaultLiveHighlightDuration,
+		out:               out,
+		refresh:           refresh,
+	}
+	if f, ok := out.(*os.File); ok {
+		lw.isTerminal = term.IsTerminal(int(f.Fd()))
+	}
+	return lw
+}
+
+// IsRenderInProgress returns true if a call to Render() was made, and Stop()
+// was not called yet.
+func (lw *LiveWriter) IsRenderInProgress() bool {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+
+	return lw.renderInProgress
+}
+
+// Render renders the table in a loop until Stop() is called, at which point
+// the table gets rendered one last time. This is a blocking call, and is meant
+// to be run in a separate goroutine. Use Update() to change the table while
+// it is being rendered, unless it is a SyncWriter.
+//
+// The loop stops right away if writing to the io.Writer fails, and the error
+// is returned; nil is returned otherwise.
+func (lw *LiveWriter) Render(tw Writer) error {
+	lw.mutex.Lock()
+	if lw.renderInProgress {
+		lw.mutex.Unlock()
+		return nil
+	}
+	var ctx context.Context
+	ctx, lw.renderContextCancel = context.WithCancel(context.Background())
+	lw.renderInProgress = true
+	lw.writer = tw
+	lw.mutex.Unlock()
+	defer func() {
+		lw.mutex.Lock()
+		lw.renderContextCancel()
+		lw.renderInProgress = false
+		lw.mutex.Unlock()
+	}()
+
+	ticker := time.NewTicker(lw.refresh)
+	defer ticker.Stop()
+
+	if err := lw.renderFrame(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ticker.C:
+			if err := lw.renderFrame(); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return lw.renderFrame()
+		}
+	}
+}
+
+// SetHighlightColors sets the colors used to highlight the cells that changed
+// since the previous frame. Set to nil to disable the highlighting.
+func (lw *LiveWriter) SetHighlightColors(colors text.Colors) {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+
+	lw.highlightColors = colors
+}
+
+// SetHighlightDuration sets for how long a cell stays highlighted after its
+// value changed.
+func (lw *LiveWriter) SetHighlightDuration(duration time.Duration) {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+
+	lw.highlightDuration = duration
+}
+
+// SetTerminalWidth sets up a sticky terminal width and prevents the
+// LiveWriter from polling for the real terminal width before every frame.
+// The rendered lines are trimmed to this width.
+func (lw *LiveWriter) SetTerminalWidth(width int) {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+
+	lw.terminalWidthOverride = width
+}
+
+// Stop stops the Render() loop after rendering the table one last time.
+func (lw *LiveWriter) Stop() {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+
+	if lw.renderContextCancel != nil {
+		lw.renderContextCancel()
+	}
+}
+
+// Update calls the given function while making sure the table is not being
+// rendered at the same time. All the changes to the table being rendered
+// should be made through this.
+func (lw *LiveWriter) Update(fn func()) {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+
+	fn()
+}
+
+func (lw *LiveWriter) getTerminalWidth() int {
+	if lw.terminalWidthOverride > 0 {
+		return lw.terminalWidthOverride
+	}
+	if f, ok := lw.out.(*os.File); ok && lw.isTerminal {
+		width, _, _ := term.GetSize(int(f.Fd()))
+		return width
+	}
+	return 0
+}
+
+// highlightChangedCells compares the rows with the ones in the previous frame
+// and returns the cells to be highlighted.
+func (lw *LiveWriter) highlightChangedCells(rows []rowStr, now time.Time) map[cellPosition]text.Colors {
+	if lw.rowsPrev != nil {
+		if lw.cellsChangedAt == nil {
+			lw.cellsChangedAt = make(map[cellPosition]time.Time)
+		}
+		for rowIdx, row := range rows {
+			for colIdx, colStr := range row {
+				var colStrPrev string
+				if rowIdx < len(lw.rowsPrev) && colIdx < len(lw.rowsPrev[rowIdx]) {
+					colStrPrev = lw.rowsPrev[rowIdx][colIdx]
+				}
+				if colStr != colStrPrev {
+					lw.cellsChangedAt[cellPosition{rowIdx: rowIdx, colIdx: colIdx}] = now
+				}
+			}
+		}
+	}
+	lw.rowsPrev = rows
+
+	var highlightedCells map[cellPosition]text.Colors
+	for pos, changedAt := range lw.cellsChangedAt {
+		if now.Sub(changedAt) >= lw.highlightDuration {
+			delete(lw.cellsChangedAt, pos)
+		} else if lw.highlightColors != nil {
+			if highlightedCells == nil {
+				highlightedCells = make(map[cellPosition]text.Colors)
+			}
+			highlightedCells[pos] = lw.highlightColors
+		}
+	}
+	return highlightedCells
+}
+
+func (lw *LiveWriter) renderFrame() error {
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+
+	// render a shallow copy to leave the state of the table (and its output
+	// mirror) alone; the rows are compared in between the initialization and
+	// the rendering to find the cells to highlight
+	frame := *writerAsTable(lw.writer)
+	frame.outputMirror = nil
+	frame.initForRender(renderModeDefault)
+	if lw.isTerminal {
+		frame.highlightedCells = lw.highlightChangedCells(frame.rows, time.Now())
+	}
+	return lw.writeFrame(frame.renderDefault())
+}
+
+// writeFrame writes the rendered table over the previous frame on a terminal,
+// or after it otherwise.
+func (lw *LiveWriter) writeFrame(frameStr string) error {
+	var out strings.Builder
+	if !lw.isTerminal {
+		if frameStr == lw.framePrev {
+			return nil
+		}
+		if lw.framePrev != "" {
+			out.WriteRune('\n')
+		}
+		out.WriteString(frameStr)
+		out.WriteRune('\n')
+		lw.framePrev = frameStr
+		_, err := lw.out.Write([]byte(out.String()))
+		return err
+	}
+
+	// move the cursor to the top of the previous frame; the lines may have
+	// wrapped around if the terminal got narrower since
+	terminalWidth := lw.getTerminalWidth()
+	for _, lineWidth := range lw.frameLineWidths {
+		numLinesToMoveUp := 1
+		if terminalWidth > 0 && lineWidth > terminalWidth {
+			numLinesToMoveUp = (lineWidth + terminalWidth - 1) / terminalWidth
+		}
+		for ; numLinesToMoveUp > 0; numLinesToMoveUp-- {
+			out.WriteString(text.CursorUp.Sprint())
+			out.WriteString(text.EraseLine.Sprint())
+		}
+	}
+
+	lw.frameLineWidths = lw.frameLineWidths[:0]
+	for _, line := range strings.Split(frameStr, "\n") {
+		if terminalWidth > 0 {
+			line = text.Trim(line, terminalWidth)
+		}
+		out.WriteString(line)
+		out.WriteString(text.EraseLine.Sprint())
+		out.WriteRune('\n')
+		lw.frameLineWidths = append(lw.frameLineWidths, text.StringWidthWithoutEscSequences(line))
+	}
+	lw.framePrev = frameStr
+	_, err := lw.out.Write([]byte(out.String()))
+	return err
+}
@@ -0,0 +1,149 @@
+package table
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+type safeBuffer struct {
+	mutex sync.Mutex
+	sb    strings.Builder
+}
+
+func (b *safeBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.sb.String()
+}
+
+func (b *safeBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.sb.Write(p)
+}
+
+func TestNewLiveWriter(t *testing.T) {
+	lw := NewLiveWriter(nil, 0)
+	assert.NotNil(t, lw.out)
+	assert.Equal(t, DefaultLiveRefresh, lw.refresh)
+	assert.Equal(t, DefaultLiveHighlightColors, lw.highlightColors)
+	assert.Equal(t, DefaultLiveHighlightDuration, lw.highlightDuration)
+
+	var out strings.Builder
+	lw = NewLiveWriter(&out, time.Millisecond)
+	assert.Equal(t, &out, lw.out)
+	assert.Equal(t, time.Millisecond, lw.refresh)
+	assert.False(t, lw.isTerminal)
+}
+
+func TestLiveWriter_Render(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Pod", "Status"})
+	tw.AppendRow(Row{"api", "Pending"})
+
+	out := &safeBuffer{}
+	lw := NewLiveWriter(out, time.Millisecond)
+	go lw.Render(tw)
+	assert.Eventually(t, lw.IsRenderInProgress, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return strings.Contains(out.String(), "Pending") }, time.Second, time.Millisecond)
+
+	lw.Update(func() {
+		tw.UpdateRow(0, Row{"api", "Running"})
+	})
+	lw.Stop()
+	assert.Eventually(t, func() bool { return !lw.IsRenderInProgress() }, time.Second, time.Millisecond)
+
+	// not a terminal: only the frames that changed get appended
+	assert.Equal(t, `+-----+---------+
+| POD | STATUS  |
++-----+---------+
+| api | Pending |
++-----+---------+
+
++-----+---------+
+| POD | STATUS  |
++-----+---------+
+| api | Running |
++-----+---------+
+`, out.String())
+}
+
+func TestLiveWriter_RenderError(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendRow(Row{"api", "Pending"})
+
+	out := &errorWriter{err: errors.New("closed")}
+	lw := NewLiveWriter(out, time.Millisecond)
+	errCh := make(chan error, 1)
+	go func() { errCh <- lw.Render(tw) }()
+
+	// the loop stops on the first write error, without waiting for Stop()
+	select {
+	case err := <-errCh:
+		assert.Equal(t, out.err, err)
+	case <-time.After(time.Second):
+		t.Fatal("Render() did not return after the write failed")
+	}
+	assert.Equal(t, 1, out.numWrites)
+	assert.False(t, lw.IsRenderInProgress())
+	lw.Stop()
+}
+
+func TestLiveWriter_Terminal(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendRow(Row{"api", "Pending"})
+	tw.AppendRow(Row{"db", "Running"})
+	tw.SetStyle(StyleLight)
+	tw.Style().Options = OptionsNoBordersAndSeparators
+
+	var out strings.Builder
+	lw := NewLiveWriter(&out, time.Second)
+	lw.isTerminal = true
+	lw.SetHighlightColors(text.Colors{text.FgRed})
+	lw.SetHighlightDuration(time.Hour)
+	lw.writer = tw
+
+	lw.renderFrame()
+	assert.Equal(t, " api  Pending "+text.EraseLine.Sprint()+"\n"+
+		" db   Running "+text.EraseLine.Sprint()+"\n", out.String())
+
+	out.Reset()
+	tw.UpdateRow(0, Row{"api", "Running"})
+	lw.renderFrame()
+	moveUp := text.CursorUp.Sprint() + text.EraseLine.Sprint()
+	assert.Equal(t, moveUp+moveUp+
+		" api "+text.FgRed.Sprint(" Running ")+text.EraseLine.Sprint()+"\n"+
+		" db   Running "+text.EraseLine.Sprint()+"\n", out.String())
+
+	// the highlight goes away once the duration elapses
+	out.Reset()
+	lw.SetHighlightDuration(0)
+	lw.renderFrame()
+	assert.Equal(t, moveUp+moveUp+
+		" api  Running "+text.EraseLine.Sprint()+"\n"+
+		" db   Running "+text.EraseLine.Sprint()+"\n", out.String())
+
+	// every frame is initialized and rendered just once
+	numTransforms := 0
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 2, Transformer: func(val interface{}) string {
+		numTransforms++
+		return val.(string)
+	}}})
+	lw.renderFrame()
+	assert.Equal(t, 2, numTransforms)
+
+	// the lines are trimmed to the terminal width, and the lines that wrapped
+	// around after the terminal got narrower are cleared as well
+	out.Reset()
+	lw.SetTerminalWidth(5)
+	lw.renderFrame()
+	assert.Equal(t, strings.Repeat(moveUp, 6)+
+		" api "+text.EraseLine.Sprint()+"\n"+
+		" db  "+text.EraseLine.Sprint()+"\n", out.String())
+}
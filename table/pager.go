@@ -17,10 +17,23 @@ type Pager interface {
 	// Render returns the current page.
 	Render() string
 	// SetOutputMirror sets up the writer to which Render() will write the
-	// output other than returning.
+	// output other than returning; use PagerRenderTo instead to find out if
+	// writing the page failed.
 	SetOutputMirror(mirror io.Writer)
 }
 
+// PagerRenderTo is implemented by the Pager returned by Table.Pager(), and
+// lets you find out if writing the current page failed. For ex.:
+//
+//	if p, ok := tw.Pager().(table.PagerRenderTo); ok {
+//		_, err := p.RenderTo(os.Stdout)
+//	}
+type PagerRenderTo interface {
+	// RenderTo writes the current page to the given io.Writer, and returns
+	// the number of bytes written along with the error from the io.Writer.
+	RenderTo(w io.Writer) (int64, error)
+}
+
 type pager struct {
 	index        int // 0-indexed
 	pages        []string
@@ -60,11 +73,16 @@ func (p *pager) Prev() string {
 func (p *pager) Render() string {
 	pageToWrite := p.pages[p.index]
 	if p.outputMirror != nil {
-		_, _ = p.outputMirror.Write([]byte(pageToWrite))
+		_, _ = p.RenderTo(p.outputMirror)
 	}
 	return pageToWrite
 }
 
+func (p *pager) RenderTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, p.pages[p.index])
+	return int64(n), err
+}
+
 func (p *pager) SetOutputMirror(mirror io.Writer) {
 	p.outputMirror = mirror
 }
@@ -1,6 +1,7 @@
 package table
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -105,4 +106,17 @@ func TestPager(t *testing.T) {
 	p.SetOutputMirror(&sb)
 	p.Render()
 	compareOutput(t, expectedOutputP4, sb.String())
+
+	pr, ok := p.(PagerRenderTo)
+	assert.True(t, ok)
+	sb.Reset()
+	n, err := pr.RenderTo(&sb)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(sb.Len()), n)
+	compareOutput(t, expectedOutputP4, sb.String())
+
+	ew := &errorWriter{err: errors.New("broken pipe")}
+	n, err = pr.RenderTo(ew)
+	assert.Equal(t, ew.err, err)
+	assert.Equal(t, int64(0), n)
 }
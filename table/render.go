@@ -21,7 +21,12 @@ import (
 //	└─────┴────────────┴───────────┴────────┴─────────────────────────────┘
 func (t *Table) Render() string {
 	t.initForRender(renderModeDefault)
+	return t.renderDefault()
+}
 
+// renderDefault renders the Table after it has been initialized for the
+// default render mode.
+func (t *Table) renderDefault() string {
 	var out strings.Builder
 	if t.numColumns > 0 {
 		t.renderTitle(&out)
@@ -81,7 +86,16 @@ func (t *Table) renderColumn(out *strings.Builder, row rowStr, colIdx int, maxCo
 	// have the same content and merge them all until a cell with a different
 	// content is found; override alignment to Center in this case
 	rowConfig := t.getRowConfig(hint)
-	if rowConfig.AutoMerge && !hint.isSeparatorRow {
+	if cgRow := t.getColumnGroupRow(hint); cgRow != nil && !hint.isSeparatorRow {
+		// column groups span the columns as defined, irrespective of content
+		align = cgRow.getAlign(colIdx)
+		if lastColIdx, ok := cgRow.spans[colIdx]; ok {
+			for idx := colIdx + 1; idx <= lastColIdx; idx++ {
+				maxColumnLength += t.getMaxColumnLengthForMerging(idx)
+				numColumnsRendered++
+			}
+		}
+	} else if rowConfig.AutoMerge && !hint.isSeparatorRow {
 		// get the real row to consider all lines in each column instead of just
 		// looking at the current "line"
 		rowUnwrapped := t.getRow(hint.rowNumber-1, hint)
@@ -97,7 +111,7 @@ func (t *Table) renderColumn(out *strings.Builder, row rowStr, colIdx int, maxCo
 
 	// pad both sides of the column
 	if !hint.isSeparatorRow || (hint.isSeparatorRow && mergeVertically) {
-		colStr = t.style.Box.PaddingLeft + align.Apply(colStr, maxColumnLength) + t.style.Box.PaddingRight
+		colStr = t.style.Box.PaddingLeft + t.applyAlign(colIdx, colStr, align, maxColumnLength, hint) + t.style.Box.PaddingRight
 	}
 
 	t.renderColumnColorized(out, colIdx, colStr, hint)
@@ -143,23 +157,8 @@ func (t *Table) renderColumnAutoIndex(out *strings.Builder, hint renderHint) {
 }
 
 func (t *Table) renderColumnColorized(out *strings.Builder, colIdx int, colStr string, hint renderHint) {
-	colors := t.getColumnColors(colIdx, hint)
-	if colors != nil {
+	if colors := t.getCellColors(colIdx, hint); colors != nil {
 		out.WriteString(colors.Sprint(colStr))
-	} else if hint.isHeaderRow && t.style.Color.Header != nil {
-		out.WriteString(t.style.Color.Header.Sprint(colStr))
-	} else if hint.isFooterRow && t.style.Color.Footer != nil {
-		out.WriteString(t.style.Color.Footer.Sprint(colStr))
-	} else if hint.isRegularRow() {
-		if colIdx == t.indexColumn-1 && t.style.Color.IndexColumn != nil {
-			out.WriteString(t.style.Color.IndexColumn.Sprint(colStr))
-		} else if hint.rowNumber%2 == 0 && t.style.Color.RowAlternate != nil {
-			out.WriteString(t.style.Color.RowAlternate.Sprint(colStr))
-		} else if t.style.Color.Row != nil {
-			out.WriteString(t.style.Color.Row.Sprint(colStr))
-		} else {
-			out.WriteString(colStr)
-		}
 	} else {
 		out.WriteString(colStr)
 	}
@@ -181,7 +180,7 @@ func (t *Table) renderColumnSeparator(out *strings.Builder, row rowStr, colIdx i
 func (t *Table) renderLine(out *strings.Builder, row rowStr, hint renderHint) {
 	// if the output has content, it means that this call is working on line
 	// number 2 or more; separate them with a newline
-	if out.Len() > 0 {
+	if t.hasRenderedOutput(out) {
 		out.WriteRune('\n')
 	}
 
@@ -296,6 +295,94 @@ func (t *Table) renderRow(out *strings.Builder, row rowStr, hint renderHint) {
 	}
 }
 
+// renderRowFullWidth renders the text in row(s) of its own spanning the full
+// width of the table, wrapping it as needed.
+func (t *Table) renderRowFullWidth(out *strings.Builder, str string, align text.Align, colors text.Colors, hint renderHint) {
+	colorsBorder := t.getBorderColors(hint)
+	lenText := t.getRowLength() - text.StringWidthWithoutEscSequences(t.style.Box.PaddingLeft+t.style.Box.PaddingRight)
+	if t.style.Options.DrawBorder {
+		lenText -= text.StringWidthWithoutEscSequences(t.style.Box.Left + t.style.Box.Right)
+	}
+	for _, line := range strings.Split(text.WrapSoft(str, lenText), "\n") {
+		line = t.style.Box.PaddingLeft + align.Apply(line, lenText) + t.style.Box.PaddingRight
+
+		if t.hasRenderedOutput(out) {
+			out.WriteRune('\n')
+		}
+		if t.style.Options.DrawBorder {
+			out.WriteString(colorsBorder.Sprint(t.style.Box.Left))
+		}
+		out.WriteString(colors.Sprint(line))
+		if t.style.Options.DrawBorder {
+			out.WriteString(colorsBorder.Sprint(t.style.Box.Right))
+		}
+	}
+}
+
+func (t *Table) renderRowNotes(out *strings.Builder, notes []string, hint renderHint) {
+	colors := t.style.Color.Row
+	if hint.rowNumber%2 == 0 && t.style.Color.RowAlternate != nil {
+		colors = t.style.Color.RowAlternate
+	}
+	for _, note := range notes {
+		t.renderRowFullWidth(out, note, text.AlignLeft, colors, hint)
+	}
+}
+
+func (t *Table) renderRowsOmitted(out *strings.Builder, numRows int, hint renderHint) {
+	if numRows == 0 {
+		return
+	}
+
+	colors := t.style.Color.RowsOmitted
+	if colors == nil {
+		colors = t.style.Color.Row
+	}
+	t.renderRowFullWidth(out, t.getRowsOmittedText(numRows), text.AlignCenter, colors, hint)
+}
+
+func (t *Table) renderRowSection(out *strings.Builder, section *rowSection, hint renderHint) {
+	hint.isSeparatorRow = true
+	hint.separatorType = separatorTypeRowMiddle
+	colorsBorder := t.getBorderColors(hint)
+	colorsSeparator := t.getSeparatorColors(hint)
+
+	// draw a separator line with the title embedded after a short lead-in
+	lenLine := t.getRowLength()
+	if t.style.Options.DrawBorder {
+		lenLine -= text.StringWidthWithoutEscSequences(t.style.Box.LeftSeparator + t.style.Box.RightSeparator)
+	}
+	horizontal := t.style.Box.middleHorizontal(separatorTypeRowMiddle)
+	if horizontal == "" {
+		horizontal = " "
+	}
+	lineStart := text.RepeatAndTrim(horizontal, 2)
+	title := strings.ReplaceAll(section.title, "\n", " ")
+	if lenTitle := lenLine - text.StringWidthWithoutEscSequences(lineStart) - 2; lenTitle <= 0 {
+		title = ""
+	} else if text.StringWidthWithoutEscSequences(title) > lenTitle {
+		title = text.Trim(title, lenTitle)
+	}
+	var titleStr string
+	if title != "" {
+		titleStr = " " + section.colors.Sprint(title) + " "
+	}
+	lineEnd := text.RepeatAndTrim(horizontal, lenLine-text.StringWidthWithoutEscSequences(lineStart+titleStr))
+
+	if t.hasRenderedOutput(out) {
+		out.WriteRune('\n')
+	}
+	if t.style.Options.DrawBorder {
+		out.WriteString(colorsBorder.Sprint(t.style.Box.LeftSeparator))
+	}
+	out.WriteString(colorsSeparator.Sprint(lineStart))
+	out.WriteString(titleStr)
+	out.WriteString(colorsSeparator.Sprint(lineEnd))
+	if t.style.Options.DrawBorder {
+		out.WriteString(colorsBorder.Sprint(t.style.Box.RightSeparator))
+	}
+}
+
 func (t *Table) renderRowSeparator(out *strings.Builder, hint renderHint) {
 	if hint.isBorderTop || hint.isBorderBottom {
 		if !t.style.Options.DrawBorder {
@@ -315,6 +402,9 @@ func (t *Table) renderRowSeparator(out *strings.Builder, hint renderHint) {
 func (t *Table) renderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
 	interleaveVerticalMerge := t.shouldInterleaveVerticalMerge(hint)
 	for rowIdx := 0; rowIdx < len(rows); rowIdx++ {
+		if !t.renderToFlush(out) {
+			return
+		}
 		row := rows[rowIdx]
 
 		// stack rows that are being vertically merged into one shared block so
@@ -330,9 +420,22 @@ func (t *Table) renderRows(out *strings.Builder, rows []rowStr, hint renderHint)
 		hint.isFirstRow = rowIdx == 0
 		hint.isLastRow = groupEnd == len(rows)
 		hint.rowNumber = rowIdx + 1
+		if rowIdx == 0 {
+			t.renderRowNotes(out, t.getRowNotes(-1, hint), hint)
+		}
+		t.renderRowsOmitted(out, t.getRowsOmitted(rowIdx, hint), hint)
+		if section := t.getRowSection(rowIdx, hint); section != nil {
+			t.renderRowSection(out, section, hint)
+			t.renderRowNotes(out, section.notes, hint)
+		}
 		t.renderRow(out, row, hint)
+		for idx := rowIdx; idx < groupEnd; idx++ {
+			t.renderRowNotes(out, t.getRowNotes(idx, hint), hint)
+		}
 
-		if t.shouldSeparateRows(rowIdx, len(rows)) {
+		// the section row below (if any) separates the rows by itself
+		separate := t.shouldSeparateRows(rowIdx, len(rows)) || t.shouldSeparateColumnGroupRow(rowIdx, len(rows), hint)
+		if separate && t.getRowSection(groupEnd, hint) == nil {
 			hintSep := hint
 			hintSep.isFirstRow = false
 			hintSep.isSeparatorRow = true
@@ -349,6 +452,7 @@ func (t *Table) renderRows(out *strings.Builder, rows []rowStr, hint renderHint)
 		// skip the rows that were stacked into the block rendered above
 		rowIdx = groupEnd - 1
 	}
+	t.renderRowsOmitted(out, t.getRowsOmitted(len(rows), hint), hint)
 }
 
 func (t *Table) renderRowsBorderBottom(out *strings.Builder) {
@@ -431,7 +535,11 @@ func (t *Table) renderRowsHeader(out *strings.Builder) {
 
 		// Only add separator after header if there are data rows or footer rows.
 		// Otherwise, the bottom border is rendered directly.
-		if len(t.rows) > 0 || len(t.rowsFooter) > 0 || !t.style.Options.DoNotRenderSeparatorWhenEmpty {
+		// The section row above the first row (if any) takes the place of the
+		// separator.
+		sectionFirst := t.getRowSection(0, renderHint{}) != nil && len(t.getRowNotes(-1, renderHint{})) == 0 &&
+			t.getRowsOmitted(0, renderHint{}) == 0
+		if !sectionFirst && (len(t.rows) > 0 || len(t.rowsFooter) > 0 || !t.style.Options.DoNotRenderSeparatorWhenEmpty) {
 			hintSeparator.separatorType = separatorTypeHeaderBottom
 			t.renderRowSeparator(out, hintSeparator)
 		}
@@ -442,13 +550,7 @@ func (t *Table) renderTitle(out *strings.Builder) {
 	if t.title != "" {
 		colors := t.style.Title.Colors
 		colorsBorder := t.getBorderColors(renderHint{isTitleRow: true})
-		rowLength := t.maxRowLength
-		if wm := t.style.Size.WidthMax; wm > 0 && wm < rowLength {
-			rowLength = wm
-		}
-		if wm := t.style.Size.WidthMin; wm > 0 && wm > rowLength {
-			rowLength = wm
-		}
+		rowLength := t.getRowLength()
 		if t.style.Options.DrawBorder {
 			lenBorder := rowLength - text.StringWidthWithoutEscSequences(t.style.Box.TopLeft+t.style.Box.TopRight)
 			middleHorizontal := t.style.Box.middleHorizontal(separatorTypeTitleTop)
@@ -474,7 +576,7 @@ func (t *Table) renderTitleLine(out *strings.Builder, lenText int, titleLine str
 	titleLine = t.style.Title.Align.Apply(titleLine, lenText)
 	titleLine = t.style.Box.PaddingLeft + titleLine + t.style.Box.PaddingRight
 
-	if out.Len() > 0 {
+	if t.hasRenderedOutput(out) {
 		out.WriteRune('\n')
 	}
 	if t.style.Options.DrawBorder {
@@ -55,7 +55,7 @@ func (t *Table) csvProtectField(str string) string {
 
 func (t *Table) csvRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
 	// when working on line number 2 or more, insert a newline first
-	if out.Len() > 0 {
+	if t.hasRenderedOutput(out) {
 		out.WriteRune('\n')
 	}
 
@@ -89,6 +89,9 @@ func (t *Table) csvRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 
 func (t *Table) csvRenderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
 	for rowIdx, row := range rows {
+		if !t.renderToFlush(out) {
+			return
+		}
 		hint.rowNumber = rowIdx + 1
 		t.csvRenderRow(out, row, hint)
 	}
@@ -2,6 +2,7 @@ package table
 
 // renderHint has hints for the Render*() logic
 type renderHint struct {
+	isAnnotationRow   bool // section or note row?
 	isAutoIndexColumn bool // auto-index column?
 	isAutoIndexRow    bool // auto-index row?
 	isBorderBottom    bool // bottom-border?
@@ -47,4 +48,5 @@ const (
 	renderModeMarkdown renderMode = "markdown"
 	renderModeTSV      renderMode = "tsv"
 	renderModeHTML     renderMode = "html"
+	renderModeSQL      renderMode = "sql"
 )
@@ -14,6 +14,22 @@ const (
 	DefaultHTMLCSSClass = "go-pretty-table"
 )
 
+// htmlCellPosition identifies a cell in the header, the footer or the regular
+// rows being rendered.
+type htmlCellPosition struct {
+	cellPosition
+	isHeaderRow bool
+	isFooterRow bool
+}
+
+func newHTMLCellPosition(colIdx int, hint renderHint) htmlCellPosition {
+	return htmlCellPosition{
+		cellPosition: cellPosition{rowIdx: hint.rowNumber - 1, colIdx: colIdx},
+		isHeaderRow:  hint.isHeaderRow,
+		isFooterRow:  hint.isFooterRow,
+	}
+}
+
 // RenderHTML renders the Table in HTML format. Example:
 //
 //	<table class="go-pretty-table">
@@ -65,23 +81,30 @@ func (t *Table) RenderHTML() string {
 	var out strings.Builder
 	if t.numColumns > 0 {
 		out.Grow(t.estimatedRenderLength())
-		out.WriteString("<table class=\"")
-		if t.htmlCSSClass != "" {
-			out.WriteString(html.EscapeString(t.htmlCSSClass))
-		} else {
-			out.WriteString(html.EscapeString(t.style.HTML.CSSClass))
-		}
-		out.WriteString("\">\n")
-		t.htmlRenderTitle(&out)
-		t.htmlRenderRowsHeader(&out)
-		t.htmlRenderRows(&out, t.rows, renderHint{})
-		t.htmlRenderRowsFooter(&out)
-		t.htmlRenderCaption(&out)
-		out.WriteString("</table>")
+		t.htmlRenderTable(&out)
 	}
 	return t.render(&out)
 }
 
+// htmlAlignAndColorsAttributes returns the attributes for the alignment and
+// the colors of a title, section, etc. (or the inline style for them).
+func (t *Table) htmlAlignAndColorsAttributes(align text.Align, colors text.Colors, borders string) string {
+	var out strings.Builder
+	if t.style.HTML.InlineStyles {
+		htmlRenderInlineStyle(&out, colors, align, text.VAlignDefault, borders)
+		return out.String()
+	}
+	if property := align.HTMLProperty(); property != "" {
+		out.WriteRune(' ')
+		out.WriteString(property)
+	}
+	if class := colors.HTMLProperty(); class != "" {
+		out.WriteRune(' ')
+		out.WriteString(class)
+	}
+	return out.String()
+}
+
 func (t *Table) htmlGetColStrAndTag(row rowStr, colIdx int, hint renderHint) (string, string) {
 	// get the column contents
 	var colStr string
@@ -98,6 +121,82 @@ func (t *Table) htmlGetColStrAndTag(row rowStr, colIdx int, hint renderHint) (st
 	return colStr, colTagName
 }
 
+// htmlInlineBorderColor returns the color of the borders in the inline styles:
+// the foreground color for the separators/borders in the Style, if any.
+func (t *Table) htmlInlineBorderColor() string {
+	if !t.style.Options.DoNotColorBordersAndSeparators {
+		colors := t.style.Color.Separator
+		if colors == nil {
+			colors = t.style.Color.Border
+		}
+		for _, color := range colors {
+			if property, value := htmlInlineDeclaration(color); property == "color" {
+				return value
+			}
+		}
+	}
+	return htmlPaletteLight.border
+}
+
+// htmlInlineBorders returns the inline CSS declarations for the borders of a
+// cell as per the separators enabled in the Style: on the left of all but the
+// first cell in the row, below the last header row, and above the first footer
+// row and all but the first regular row.
+func (t *Table) htmlInlineBorders(hasCellOnLeft bool, hint renderHint) string {
+	var borders []string
+	border := "1px solid " + t.htmlInlineBorderColor() + ";"
+	if hasCellOnLeft && t.style.Options.SeparateColumns && strings.TrimSpace(t.style.Box.MiddleVertical) != "" {
+		borders = append(borders, "border-left: "+border)
+	}
+	if strings.TrimSpace(t.style.Box.MiddleHorizontal) != "" {
+		switch {
+		case hint.isHeaderRow:
+			if t.style.Options.SeparateHeader && (hint.isAutoIndexRow || hint.rowNumber == len(t.rowsHeader)) {
+				borders = append(borders, "border-bottom: "+border)
+			}
+		case hint.isFooterRow:
+			if t.style.Options.SeparateFooter && hint.rowNumber == 1 {
+				borders = append(borders, "border-top: "+border)
+			}
+		default:
+			if t.style.Options.SeparateRows && hint.rowNumber > 1 {
+				borders = append(borders, "border-top: "+border)
+			}
+		}
+	}
+	return strings.Join(borders, " ")
+}
+
+// htmlInlineAlign returns the CSS "text-align" value for the alignment.
+func htmlInlineAlign(align text.Align) string {
+	switch align {
+	case text.AlignLeft:
+		return "left"
+	case text.AlignCenter:
+		return "center"
+	case text.AlignJustify:
+		return "justify"
+	case text.AlignRight, text.AlignDecimal:
+		return "right"
+	default:
+		return ""
+	}
+}
+
+// htmlInlineVAlign returns the CSS "vertical-align" value for the alignment.
+func htmlInlineVAlign(vAlign text.VAlign) string {
+	switch vAlign {
+	case text.VAlignTop:
+		return "top"
+	case text.VAlignMiddle:
+		return "middle"
+	case text.VAlignBottom:
+		return "bottom"
+	default:
+		return ""
+	}
+}
+
 func (t *Table) htmlRenderCaption(out *strings.Builder) {
 	if t.caption != "" {
 		caption := t.caption
@@ -110,13 +209,9 @@ func (t *Table) htmlRenderCaption(out *strings.Builder) {
 	}
 }
 
-func (t *Table) htmlRenderColumn(out *strings.Builder, colStr string) {
-	// convertEscSequencesToSpans already escapes text content, so skip
-	// EscapeText if ConvertColorsToSpans is true
-	if t.style.HTML.ConvertColorsToSpans {
-		colStr = convertEscSequencesToSpans(colStr)
-	} else if t.style.HTML.EscapeText {
-		colStr = html.EscapeString(colStr)
+func (t *Table) htmlRenderColumn(out *strings.Builder, colStr string, isHTMLSafe bool) {
+	if !isHTMLSafe {
+		colStr = t.htmlEscape(colStr)
 	}
 	if t.style.HTML.Newline != "\n" {
 		colStr = strings.ReplaceAll(colStr, "\n", t.style.HTML.Newline)
@@ -124,9 +219,36 @@ func (t *Table) htmlRenderColumn(out *strings.Builder, colStr string) {
 	out.WriteString(colStr)
 }
 
+// htmlEscape converts the text into HTML-safe content as directed by the
+// HTML options in the Style.
+func (t *Table) htmlEscape(str string) string {
+	// convertEscSequencesToSpans already escapes text content, so skip
+	// EscapeText if ConvertColorsToSpans is true
+	if t.style.HTML.ConvertColorsToSpans && t.style.HTML.InlineStyles {
+		return convertEscSequencesToInlineStyles(str)
+	} else if t.style.HTML.ConvertColorsToSpans {
+		return convertEscSequencesToSpans(str)
+	} else if t.style.HTML.EscapeText {
+		return html.EscapeString(str)
+	}
+	return str
+}
+
 func (t *Table) htmlRenderColumnAttributes(out *strings.Builder, colIdx int, hint renderHint, alignOverride text.Align) {
+	if t.style.HTML.InlineStyles {
+		colors := t.getCellColors(colIdx, hint)
+		borders := t.htmlInlineBorders(colIdx > 0 || t.autoIndex, hint)
+		htmlRenderInlineStyle(out, colors, alignOverride, t.getVAlign(colIdx, hint), borders)
+		return
+	}
+
 	// determine the HTML "align"/"valign" property values
 	align := alignOverride.HTMLProperty()
+	if alignOverride == text.AlignDecimal {
+		if separator := t.columnConfigMap[colIdx].DecimalSeparator; separator != "" {
+			align = fmt.Sprintf("align=\"char\" char=\"%s\"", html.EscapeString(separator))
+		}
+	}
 	vAlign := t.getVAlign(colIdx, hint).HTMLProperty()
 	// determine the HTML "class" property values for the colors
 	class := t.getColumnColors(colIdx, hint).HTMLProperty()
@@ -146,8 +268,12 @@ func (t *Table) htmlRenderColumnAttributes(out *strings.Builder, colIdx int, hin
 }
 
 func (t *Table) htmlRenderColumnAutoIndex(out *strings.Builder, hint renderHint) {
-	if hint.isHeaderRow {
-		out.WriteString("    <th>")
+	if t.style.HTML.InlineStyles {
+		t.htmlRenderColumnAutoIndexInline(out, hint)
+	} else if hint.isHeaderRow {
+		out.WriteString("    <th")
+		t.htmlRenderColumnScope(out, false, hint)
+		out.WriteString(">")
 		out.WriteString(t.style.HTML.EmptyColumn)
 		out.WriteString("</th>\n")
 	} else if hint.isFooterRow {
@@ -161,6 +287,69 @@ func (t *Table) htmlRenderColumnAutoIndex(out *strings.Builder, hint renderHint)
 	}
 }
 
+func (t *Table) htmlRenderColumnAutoIndexInline(out *strings.Builder, hint renderHint) {
+	colors, colTagName := t.style.Color.IndexColumn, "td"
+	if hint.isFooterRow {
+		colors = t.style.Color.Footer
+	} else if hint.isHeaderRow {
+		colTagName = "th"
+	}
+	out.WriteString("    <")
+	out.WriteString(colTagName)
+	align := text.AlignRight
+	if !hint.isRegularRow() {
+		align = text.AlignDefault
+	}
+	htmlRenderInlineStyle(out, colors, align, text.VAlignDefault, t.htmlInlineBorders(false, hint))
+	t.htmlRenderColumnScope(out, false, hint)
+	out.WriteString(">")
+	if hint.isRegularRow() {
+		fmt.Fprint(out, hint.rowNumber)
+	} else {
+		out.WriteString(t.style.HTML.EmptyColumn)
+	}
+	out.WriteString("</")
+	out.WriteString(colTagName)
+	out.WriteString(">\n")
+}
+
+// htmlRenderColumnScope renders the "scope" attribute of the header cells in
+// an HTML document, for the screen readers.
+func (t *Table) htmlRenderColumnScope(out *strings.Builder, isGroup bool, hint renderHint) {
+	if !t.htmlDocument || !hint.isHeaderRow {
+		return
+	}
+	if isGroup {
+		out.WriteString(" scope=\"colgroup\"")
+	} else {
+		out.WriteString(" scope=\"col\"")
+	}
+}
+
+// htmlRenderInlineStyle renders the "style" attribute of a cell with the
+// colors, alignment and borders, in place of the CSS classes and the
+// "align"/"valign" attributes.
+func htmlRenderInlineStyle(out *strings.Builder, colors text.Colors, align text.Align, vAlign text.VAlign, borders string) {
+	var declarations []string
+	if style := htmlInlineStyle(colors); style != "" {
+		declarations = append(declarations, style)
+	}
+	if value := htmlInlineAlign(align); value != "" {
+		declarations = append(declarations, "text-align: "+value+";")
+	}
+	if value := htmlInlineVAlign(vAlign); value != "" {
+		declarations = append(declarations, "vertical-align: "+value+";")
+	}
+	if borders != "" {
+		declarations = append(declarations, borders)
+	}
+	if len(declarations) > 0 {
+		out.WriteString(" style=\"")
+		out.WriteString(strings.Join(declarations, " "))
+		out.WriteString("\"")
+	}
+}
+
 func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
 	out.WriteString("  <tr>\n")
 	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
@@ -176,7 +365,12 @@ func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 		align := t.getAlign(colIdx, hint)
 		rowConfig := t.getRowConfig(hint)
 		extraColumnsRendered := 0
-		if rowConfig.AutoMerge && !hint.isSeparatorRow {
+		if cgRow := t.getColumnGroupRow(hint); cgRow != nil {
+			align = cgRow.getAlign(colIdx)
+			if lastColIdx, ok := cgRow.spans[colIdx]; ok {
+				extraColumnsRendered = lastColIdx - colIdx
+			}
+		} else if rowConfig.AutoMerge && !hint.isSeparatorRow {
 			// get the real row to consider all lines in each column instead of just
 			// looking at the current "line"
 			rowUnwrapped := t.getRow(hint.rowNumber-1, hint)
@@ -194,6 +388,7 @@ func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 		out.WriteString("    <")
 		out.WriteString(colTagName)
 		t.htmlRenderColumnAttributes(out, colIdx, hint, align)
+		t.htmlRenderColumnScope(out, extraColumnsRendered > 0, hint)
 		if extraColumnsRendered > 0 {
 			out.WriteString(" colspan=")
 			fmt.Fprint(out, extraColumnsRendered+1)
@@ -205,7 +400,7 @@ func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 		if len(colStr) == 0 {
 			out.WriteString(t.style.HTML.EmptyColumn)
 		} else {
-			t.htmlRenderColumn(out, colStr)
+			t.htmlRenderColumn(out, colStr, t.htmlSafeCells[newHTMLCellPosition(colIdx, hint)])
 		}
 		out.WriteString("</")
 		out.WriteString(colTagName)
@@ -215,6 +410,49 @@ func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 	out.WriteString("  </tr>\n")
 }
 
+// htmlRenderRowAnnotation renders a section (as a header cell) or a note in a
+// row of its own, spanning all the columns.
+func (t *Table) htmlRenderRowAnnotation(out *strings.Builder, annotation string, colTagName string, attributes string) {
+	numColumns := t.numColumns
+	if t.autoIndex {
+		numColumns++
+	}
+
+	out.WriteString("  <tr>\n")
+	out.WriteString("    <")
+	out.WriteString(colTagName)
+	out.WriteString(attributes)
+	if numColumns > 1 {
+		out.WriteString(" colspan=")
+		fmt.Fprint(out, numColumns)
+	}
+	out.WriteString(">")
+	if len(annotation) == 0 {
+		out.WriteString(t.style.HTML.EmptyColumn)
+	} else {
+		t.htmlRenderColumn(out, annotation, false)
+	}
+	out.WriteString("</")
+	out.WriteString(colTagName)
+	out.WriteString(">\n")
+	out.WriteString("  </tr>\n")
+}
+
+func (t *Table) htmlRenderRowNotes(out *strings.Builder, notes []string) {
+	for _, note := range notes {
+		t.htmlRenderRowAnnotation(out, note, "td", "")
+	}
+}
+
+func (t *Table) htmlRenderRowSection(out *strings.Builder, section *rowSection, hint renderHint) {
+	attributes := t.htmlAlignAndColorsAttributes(text.AlignLeft, section.colors, t.htmlInlineBorders(false, hint))
+	if t.htmlDocument {
+		attributes += " scope=\"rowgroup\""
+	}
+	t.htmlRenderRowAnnotation(out, section.title, "th", attributes)
+	t.htmlRenderRowNotes(out, section.notes)
+}
+
 func (t *Table) htmlRenderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
 	if len(rows) > 0 {
 		// determine that tag to use based on the type of the row
@@ -227,6 +465,9 @@ func (t *Table) htmlRenderRows(out *strings.Builder, rows []rowStr, hint renderH
 
 		var renderedTagOpen, shouldRenderTagClose bool
 		for idx, row := range rows {
+			if !t.renderToFlush(out) {
+				return
+			}
 			hint.rowNumber = idx + 1
 			if len(row) > 0 {
 				if !renderedTagOpen {
@@ -235,7 +476,18 @@ func (t *Table) htmlRenderRows(out *strings.Builder, rows []rowStr, hint renderH
 					out.WriteString(">\n")
 					renderedTagOpen = true
 				}
+				if idx == 0 {
+					t.htmlRenderRowNotes(out, t.getRowNotes(-1, hint))
+				}
+				t.htmlRenderRowsOmitted(out, t.getRowsOmitted(idx, hint))
+				if section := t.getRowSection(idx, hint); section != nil {
+					t.htmlRenderRowSection(out, section, hint)
+				}
 				t.htmlRenderRow(out, row, hint)
+				t.htmlRenderRowNotes(out, t.getRowNotes(idx, hint))
+				if idx == len(rows)-1 {
+					t.htmlRenderRowsOmitted(out, t.getRowsOmitted(len(rows), hint))
+				}
 				shouldRenderTagClose = true
 			}
 			t.firstRowOfPage = false
@@ -248,6 +500,19 @@ func (t *Table) htmlRenderRows(out *strings.Builder, rows []rowStr, hint renderH
 	}
 }
 
+func (t *Table) htmlRenderRowsOmitted(out *strings.Builder, numRows int) {
+	if numRows == 0 {
+		return
+	}
+
+	colors := t.style.Color.RowsOmitted
+	if colors == nil {
+		colors = t.style.Color.Row
+	}
+	attributes := t.htmlAlignAndColorsAttributes(text.AlignCenter, colors, "")
+	t.htmlRenderRowAnnotation(out, t.getRowsOmittedText(numRows), "td", attributes)
+}
+
 func (t *Table) htmlRenderRowsFooter(out *strings.Builder) {
 	if len(t.rowsFooter) > 0 {
 		t.htmlRenderRows(out, t.rowsFooter, renderHint{isFooterRow: true})
@@ -263,24 +528,41 @@ func (t *Table) htmlRenderRowsHeader(out *strings.Builder) {
 	}
 }
 
+func (t *Table) htmlRenderTable(out *strings.Builder) {
+	out.WriteString("<table class=\"")
+	if t.htmlCSSClass != "" {
+		out.WriteString(html.EscapeString(t.htmlCSSClass))
+	} else {
+		out.WriteString(html.EscapeString(t.style.HTML.CSSClass))
+	}
+	out.WriteString("\"")
+	if t.style.HTML.InlineStyles {
+		out.WriteString(" style=\"border-collapse: collapse;")
+		if t.style.Options.DrawBorder && strings.TrimSpace(t.style.Box.Left) != "" {
+			out.WriteString(" border: 1px solid ")
+			out.WriteString(t.htmlInlineBorderColor())
+			out.WriteString(";")
+		}
+		out.WriteString("\"")
+	}
+	out.WriteString(">\n")
+	t.htmlRenderTitle(out)
+	t.htmlRenderRowsHeader(out)
+	t.htmlRenderRows(out, t.rows, renderHint{})
+	t.htmlRenderRowsFooter(out)
+	t.htmlRenderCaption(out)
+	out.WriteString("</table>")
+}
+
 func (t *Table) htmlRenderTitle(out *strings.Builder) {
 	if t.title != "" {
-		align := t.style.Title.Align.HTMLProperty()
-		colors := t.style.Title.Colors.HTMLProperty()
 		title := t.style.Title.Format.Apply(t.title)
 		if t.style.HTML.EscapeText {
 			title = html.EscapeString(title)
 		}
 
 		out.WriteString("  <caption class=\"title\"")
-		if align != "" {
-			out.WriteRune(' ')
-			out.WriteString(align)
-		}
-		if colors != "" {
-			out.WriteRune(' ')
-			out.WriteString(colors)
-		}
+		out.WriteString(t.htmlAlignAndColorsAttributes(t.style.Title.Align, t.style.Title.Colors, ""))
 		out.WriteRune('>')
 		out.WriteString(title)
 		out.WriteString("</caption>\n")
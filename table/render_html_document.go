@@ -0,0 +1,319 @@
+package table
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// HTMLTheme defines the colors used in the document rendered by
+// RenderHTMLDocument.
+type HTMLTheme string
+
+// Supported HTML themes.
+const (
+	// HTMLThemeAuto follows the color scheme preferred by the browser.
+	HTMLThemeAuto HTMLTheme = ""
+	// HTMLThemeDark uses light colors on a dark background.
+	HTMLThemeDark HTMLTheme = "dark"
+	// HTMLThemeLight uses dark colors on a light background.
+	HTMLThemeLight HTMLTheme = "light"
+)
+
+// HTMLDocumentOptions defines options to control the HTML document rendered
+// by RenderHTMLDocument.
+type HTMLDocumentOptions struct {
+	// Filterable adds a text box above the table to show only the rows with
+	// the text typed in it
+	Filterable bool
+	// Sortable sorts the rows on clicking on the column headers (or pressing
+	// Enter on them), alternating between the ascending and descending order
+	Sortable bool
+	// StickyHeader keeps the header rows in view while scrolling the rows
+	StickyHeader bool
+	// Theme defines the colors to use; default: HTMLThemeAuto
+	Theme HTMLTheme
+	// Title is the title of the page; defaults to the title of the Table
+	Title string
+}
+
+var (
+	// DefaultHTMLDocumentOptions defines sensible HTML document defaults.
+	DefaultHTMLDocumentOptions = HTMLDocumentOptions{
+		Filterable:   true,
+		Sortable:     true,
+		StickyHeader: true,
+		Theme:        HTMLThemeAuto,
+	}
+)
+
+// htmlPalette contains the colors of the page, and the 16 basic colors (in the
+// order: black, red, green, yellow, blue, magenta, cyan, white, and their
+// "Hi" variants) to use in place of the color classes.
+type htmlPalette struct {
+	background       string
+	backgroundHeader string
+	border           string
+	colors           [16]string
+	foreground       string
+}
+
+// HTML document related variables
+var (
+	htmlPaletteDark = htmlPalette{
+		background:       "#0d1117",
+		backgroundHeader: "#161b22",
+		border:           "#30363d",
+		colors: [16]string{
+			"#000000", "#cd3131", "#0dbc79", "#e5e510", "#2472c8", "#bc3fbc", "#11a8cd", "#e5e5e5",
+			"#666666", "#f14c4c", "#23d18b", "#f5f543", "#3b8eea", "#d670d6", "#29b8db", "#ffffff",
+		},
+		foreground: "#e6edf3",
+	}
+	htmlPaletteLight = htmlPalette{
+		background:       "#ffffff",
+		backgroundHeader: "#f6f8fa",
+		border:           "#d0d7de",
+		colors: [16]string{
+			"#000000", "#cd3131", "#00bc00", "#949800", "#0451a5", "#bc05bc", "#0598bc", "#555555",
+			"#666666", "#cd3131", "#14ce14", "#b5ba00", "#0451a5", "#bc05bc", "#0598bc", "#a5a5a5",
+		},
+		foreground: "#1f2328",
+	}
+	// htmlStyleAttributes contains the CSS declarations for the classes of the
+	// non-color attributes
+	htmlStyleAttributes = []struct {
+		color       text.Color
+		declaration string
+	}{
+		{text.Bold, "font-weight: bold;"},
+		{text.Faint, "opacity: 0.6;"},
+		{text.Italic, "font-style: italic;"},
+		{text.Underline, "text-decoration: underline;"},
+		{text.BlinkSlow, "animation: go-pretty-blink 1s step-end infinite;"},
+		{text.BlinkRapid, "animation: go-pretty-blink 0.3s step-end infinite;"},
+		{text.ReverseVideo, "filter: invert(100%);"},
+		{text.Concealed, "visibility: hidden;"},
+		{text.CrossedOut, "text-decoration: line-through;"},
+	}
+)
+
+// RenderHTMLDocument renders the Table in HTML format (like RenderHTML) within
+// a self-contained HTML page, with:
+//   - a stylesheet for all the CSS classes generated for the colors (refer to
+//     HTMLOptions.ConvertColorsToSpans) in light and/or dark themes
+//   - the "scope" attribute on the header cells, for the screen readers
+//   - the optional inline JavaScript to sort and filter the rows (the rows
+//     are sorted within the sections, and the notes stay in place)
+//
+// Example:
+//
+//	<!DOCTYPE html>
+//	<html lang="en">
+//	<head>
+//	  <meta charset="utf-8">
+//	  <meta name="viewport" content="width=device-width, initial-scale=1">
+//	  <title>Game of Thrones</title>
+//	  <style>
+//	  ...
+//	  </style>
+//	</head>
+//	<body>
+//	<input type="search" id="go-pretty-table-filter" placeholder="Filter..." aria-label="Filter rows">
+//	<table class="go-pretty-table">
+//	  ...
+//	</table>
+//	<script>
+//	...
+//	</script>
+//	</body>
+//	</html>
+func (t *Table) RenderHTMLDocument(opts HTMLDocumentOptions) string {
+	t.initForRender(renderModeHTML)
+	t.htmlDocument = true
+	defer func() {
+		t.htmlDocument = false
+	}()
+
+	var out strings.Builder
+	out.Grow(t.estimatedRenderLength())
+	t.htmlDocumentRenderHead(&out, opts)
+	out.WriteString("<body>\n")
+	if t.numColumns > 0 {
+		if opts.Filterable {
+			out.WriteString("<input type=\"search\" id=\"go-pretty-table-filter\" placeholder=\"Filter...\" aria-label=\"Filter rows\">\n")
+		}
+		t.htmlRenderTable(&out)
+		out.WriteRune('\n')
+		if opts.Filterable || opts.Sortable {
+			htmlDocumentRenderScript(&out, opts)
+		}
+	}
+	out.WriteString("</body>\n")
+	out.WriteString("</html>")
+	return t.render(&out)
+}
+
+func (t *Table) htmlDocumentRenderHead(out *strings.Builder, opts HTMLDocumentOptions) {
+	title := opts.Title
+	if title == "" {
+		title = text.StripEscape(t.title)
+	}
+
+	out.WriteString("<!DOCTYPE html>\n")
+	out.WriteString("<html lang=\"en\">\n")
+	out.WriteString("<head>\n")
+	out.WriteString("  <meta charset=\"utf-8\">\n")
+	out.WriteString("  <meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	if title != "" {
+		out.WriteString("  <title>")
+		out.WriteString(html.EscapeString(title))
+		out.WriteString("</title>\n")
+	}
+	out.WriteString("  <style>\n")
+	htmlDocumentRenderStyle(out, opts)
+	out.WriteString("  </style>\n")
+	out.WriteString("</head>\n")
+}
+
+func htmlDocumentRenderScript(out *strings.Builder, opts HTMLDocumentOptions) {
+	out.WriteString("<script>\n")
+	out.WriteString(`(function () {
+  var table = document.querySelector("table"), tbody = table.tBodies[0];
+  if (!tbody) {
+    return;
+  }
+  // rows with cells spanning columns are sections, notes, etc.
+  var isRegularRow = function (row) {
+    return Array.prototype.every.call(row.cells, function (cell) {
+      return cell.colSpan <= 1;
+    });
+  };
+`)
+	if opts.Sortable {
+		out.WriteString(`  var compare = function (a, b) {
+    var numA = a === "" ? NaN : Number(a.replace(/,/g, "")), numB = b === "" ? NaN : Number(b.replace(/,/g, ""));
+    if (!isNaN(numA) && !isNaN(numB)) {
+      return numA - numB;
+    }
+    return a.localeCompare(b, undefined, {numeric: true});
+  };
+  var headerRow = table.tHead ? table.tHead.rows[table.tHead.rows.length - 1] : null;
+  Array.prototype.forEach.call(headerRow ? headerRow.cells : [], function (th, colIdx) {
+    var sort = function () {
+      var ascending = th.getAttribute("aria-sort") !== "ascending";
+      Array.prototype.forEach.call(headerRow.cells, function (cell) {
+        cell.setAttribute("aria-sort", "none");
+      });
+      th.setAttribute("aria-sort", ascending ? "ascending" : "descending");
+      var value = function (row) {
+        return row.cells[colIdx] ? row.cells[colIdx].textContent.trim() : "";
+      };
+      var sorted = [], group = [];
+      var flush = function () {
+        group.sort(function (rowA, rowB) {
+          var result = compare(value(rowA), value(rowB));
+          return ascending ? result : -result;
+        });
+        sorted = sorted.concat(group);
+        group = [];
+      };
+      Array.prototype.forEach.call(tbody.rows, function (row) {
+        if (isRegularRow(row)) {
+          group.push(row);
+        } else {
+          flush();
+          sorted.push(row);
+        }
+      });
+      flush();
+      sorted.forEach(function (row) {
+        tbody.appendChild(row);
+      });
+    };
+    th.setAttribute("aria-sort", "none");
+    th.tabIndex = 0;
+    th.addEventListener("click", sort);
+    th.addEventListener("keydown", function (event) {
+      if (event.key === "Enter" || event.key === " ") {
+        event.preventDefault();
+        sort();
+      }
+    });
+  });
+`)
+	}
+	if opts.Filterable {
+		out.WriteString(`  var filter = document.getElementById("go-pretty-table-filter");
+  filter.addEventListener("input", function () {
+    var query = filter.value.toLowerCase();
+    Array.prototype.forEach.call(tbody.rows, function (row) {
+      row.hidden = isRegularRow(row) && row.textContent.toLowerCase().indexOf(query) < 0;
+    });
+  });
+`)
+	}
+	out.WriteString("})();\n")
+	out.WriteString("</script>\n")
+}
+
+func htmlDocumentRenderStyle(out *strings.Builder, opts HTMLDocumentOptions) {
+	out.WriteString("  body { font-family: sans-serif; margin: 1em; }\n")
+	out.WriteString("  table { border-collapse: collapse; }\n")
+	out.WriteString("  th, td { padding: 0.25em 0.5em; }\n")
+	out.WriteString("  #go-pretty-table-filter { margin-bottom: 0.5em; padding: 0.25em; }\n")
+	if opts.StickyHeader {
+		out.WriteString("  thead { position: sticky; top: 0; z-index: 1; }\n")
+	}
+	if opts.Sortable {
+		out.WriteString("  th[aria-sort] { cursor: pointer; }\n")
+		out.WriteString("  th[aria-sort=\"ascending\"]::after { content: \" \\25B2\"; }\n")
+		out.WriteString("  th[aria-sort=\"descending\"]::after { content: \" \\25BC\"; }\n")
+	}
+	out.WriteString("  @keyframes go-pretty-blink { 50% { opacity: 0; } }\n")
+	for _, attribute := range htmlStyleAttributes {
+		fmt.Fprintf(out, "  .%s { %s }\n", attribute.color.CSSClasses(), attribute.declaration)
+	}
+	// the 256-colors are the same in all the themes; some of them share the
+	// same RGB values, and hence the classes
+	rendered := make(map[string]bool)
+	for idx := 0; idx < 256; idx++ {
+		fg, bg := text.Fg256Color(idx).CSSClasses(), text.Bg256Color(idx).CSSClasses()
+		var r, g, b int
+		if _, err := fmt.Sscanf(fg, "fg-256-%d-%d-%d", &r, &g, &b); err == nil && !rendered[fg] {
+			rendered[fg] = true
+			fmt.Fprintf(out, "  .%s { color: rgb(%d, %d, %d); }\n", fg, r, g, b)
+			fmt.Fprintf(out, "  .%s { background-color: rgb(%d, %d, %d); }\n", bg, r, g, b)
+		}
+	}
+
+	switch opts.Theme {
+	case HTMLThemeDark:
+		out.WriteString("  :root { color-scheme: dark; }\n")
+		htmlDocumentRenderTheme(out, htmlPaletteDark, "  ")
+	case HTMLThemeLight:
+		out.WriteString("  :root { color-scheme: light; }\n")
+		htmlDocumentRenderTheme(out, htmlPaletteLight, "  ")
+	default:
+		out.WriteString("  :root { color-scheme: light dark; }\n")
+		htmlDocumentRenderTheme(out, htmlPaletteLight, "  ")
+		out.WriteString("  @media (prefers-color-scheme: dark) {\n")
+		htmlDocumentRenderTheme(out, htmlPaletteDark, "    ")
+		out.WriteString("  }\n")
+	}
+}
+
+// htmlDocumentRenderTheme renders the rules for the colors of the page and the
+// basic color classes using the given palette.
+func htmlDocumentRenderTheme(out *strings.Builder, palette htmlPalette, indent string) {
+	fmt.Fprintf(out, "%sbody { background-color: %s; color: %s; }\n", indent, palette.background, palette.foreground)
+	fmt.Fprintf(out, "%sth, td { border: 1px solid %s; }\n", indent, palette.border)
+	fmt.Fprintf(out, "%sthead, tfoot { background-color: %s; }\n", indent, palette.backgroundHeader)
+	for idx, color := range palette.colors {
+		fg, bg := htmlBasicColor(idx)
+		fmt.Fprintf(out, "%s.%s { color: %s; }\n", indent, fg.CSSClasses(), color)
+		fmt.Fprintf(out, "%s.%s { background-color: %s; }\n", indent, bg.CSSClasses(), color)
+	}
+}
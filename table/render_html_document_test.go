@@ -0,0 +1,145 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_RenderHTMLDocument(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendSection("The North", text.FgRed)
+	tw.AppendRow(Row{4, text.FgHiRed.Sprint("Sansa"), text.Fg256Color(208).Sprint("Stark"), 1000})
+	tw.SetTitle(testTitle1)
+	tw.SetColumnGroups([]ColumnGroup{{Title: "Name", FromCol: 2, ToCol: 3}})
+
+	out := tw.RenderHTMLDocument(DefaultHTMLDocumentOptions)
+	assert.True(t, strings.HasPrefix(out, `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>Game of Thrones</title>
+  <style>
+  body { font-family: sans-serif; margin: 1em; }
+  table { border-collapse: collapse; }
+  th, td { padding: 0.25em 0.5em; }
+  #go-pretty-table-filter { margin-bottom: 0.5em; padding: 0.25em; }
+  thead { position: sticky; top: 0; z-index: 1; }
+  th[aria-sort] { cursor: pointer; }
+  th[aria-sort="ascending"]::after { content: " \25B2"; }
+  th[aria-sort="descending"]::after { content: " \25BC"; }
+  @keyframes go-pretty-blink { 50% { opacity: 0; } }
+  .bold { font-weight: bold; }
+`), out)
+	// a rule for every color class used in the table, in both the themes
+	assert.Contains(t, out, "\n  .fg-256-255-102-0 { color: rgb(255, 102, 0); }\n")
+	assert.Contains(t, out, "\n  .bg-256-255-102-0 { background-color: rgb(255, 102, 0); }\n")
+	assert.Contains(t, out, "\n  .fg-hi-red { color: #cd3131; }\n")
+	assert.Contains(t, out, "\n  @media (prefers-color-scheme: dark) {\n")
+	assert.Contains(t, out, "\n    .fg-hi-red { color: #f14c4c; }\n")
+	assert.Contains(t, out, "\n    .bg-cyan { background-color: #11a8cd; }\n")
+	assert.Equal(t, 1, strings.Count(out, ".fg-256-0-0-0 {"))
+
+	body := out[strings.Index(out, "<body>"):strings.Index(out, "<script>")]
+	compareOutput(t, body, `
+<body>
+<input type="search" id="go-pretty-table-filter" placeholder="Filter..." aria-label="Filter rows">
+<table class="go-pretty-table">
+  <caption class="title">Game of Thrones</caption>
+  <thead>
+  <tr>
+    <th scope="col">&nbsp;</th>
+    <th align="center" scope="colgroup" colspan=2>Name</th>
+    <th scope="col">&nbsp;</th>
+    <th scope="col">&nbsp;</th>
+  </tr>
+  <tr>
+    <th align="right" scope="col">#</th>
+    <th scope="col">First Name</th>
+    <th scope="col">Last Name</th>
+    <th align="right" scope="col">Salary</th>
+    <th scope="col">&nbsp;</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td align="right">1</td>
+    <td>Arya</td>
+    <td>Stark</td>
+    <td align="right">3000</td>
+    <td>&nbsp;</td>
+  </tr>
+  <tr>
+    <td align="right">20</td>
+    <td>Jon</td>
+    <td>Snow</td>
+    <td align="right">2000</td>
+    <td>You know nothing, Jon Snow!</td>
+  </tr>
+  <tr>
+    <td align="right">300</td>
+    <td>Tyrion</td>
+    <td>Lannister</td>
+    <td align="right">5000</td>
+    <td>&nbsp;</td>
+  </tr>
+  <tr>
+    <th align="left" class="fg-red" scope="rowgroup" colspan=5>The North</th>
+  </tr>
+  <tr>
+    <td align="right">4</td>
+    <td><span class="fg-hi-red">Sansa</span></td>
+    <td><span class="fg-256-255-102-0">Stark</span></td>
+    <td align="right">1000</td>
+    <td>&nbsp;</td>
+  </tr>
+  </tbody>
+</table>
+`)
+	assert.Contains(t, out, `th.setAttribute("aria-sort", "none");`)
+	assert.Contains(t, out, `document.getElementById("go-pretty-table-filter")`)
+	assert.True(t, strings.HasSuffix(out, "})();\n</script>\n</body>\n</html>"))
+
+	t.Run("does not change RenderHTML", func(t *testing.T) {
+		assert.NotContains(t, tw.RenderHTML(), "scope=")
+	})
+
+	t.Run("options", func(t *testing.T) {
+		out := tw.RenderHTMLDocument(HTMLDocumentOptions{Theme: HTMLThemeDark, Title: "<GoT>"})
+		assert.Contains(t, out, "  <title>&lt;GoT&gt;</title>\n")
+		assert.Contains(t, out, "  :root { color-scheme: dark; }\n")
+		assert.Contains(t, out, "\n  .fg-hi-red { color: #f14c4c; }\n")
+		assert.NotContains(t, out, "@media")
+		assert.NotContains(t, out, "sticky")
+		assert.NotContains(t, out, "aria-sort")
+		assert.NotContains(t, out, "<input")
+		assert.NotContains(t, out, "<script>")
+
+		out = tw.RenderHTMLDocument(HTMLDocumentOptions{Sortable: true, Theme: HTMLThemeLight})
+		assert.Contains(t, out, "  :root { color-scheme: light; }\n")
+		assert.Contains(t, out, "\n  .fg-hi-red { color: #cd3131; }\n")
+		assert.NotContains(t, out, "@media")
+		assert.Contains(t, out, `th.setAttribute("aria-sort", "none");`)
+		assert.NotContains(t, out, "<input")
+		assert.NotContains(t, out, "go-pretty-table-filter\")")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		out := NewWriter().RenderHTMLDocument(DefaultHTMLDocumentOptions)
+		assert.NotContains(t, out, "<title>")
+		assert.True(t, strings.HasSuffix(out, "  </style>\n</head>\n<body>\n</body>\n</html>"))
+	})
+
+	t.Run("render to", func(t *testing.T) {
+		var sb strings.Builder
+		n, err := tw.RenderHTMLDocumentTo(&sb, DefaultHTMLDocumentOptions)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(sb.Len()), n)
+		assert.Equal(t, tw.RenderHTMLDocument(DefaultHTMLDocumentOptions)+"\n", sb.String())
+	})
+}
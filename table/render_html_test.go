@@ -82,6 +82,34 @@ func TestTable_RenderHTML(t *testing.T) {
 </table>`)
 }
 
+func TestTable_RenderHTML_AlignDecimal(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Item", "Price", "Price (EUR)"})
+	tw.AppendRow(Row{"Banana", 12.5, "12,5"})
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Price", Align: text.AlignDecimal},
+		{Name: "Price (EUR)", Align: text.AlignDecimal, DecimalSeparator: ","},
+	})
+
+	compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Item</th>
+    <th align="right">Price</th>
+    <th>Price (EUR)</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>Banana</td>
+    <td align="char" char=".">12.5</td>
+    <td align="char" char=",">12,5</td>
+  </tr>
+  </tbody>
+</table>`)
+}
+
 func TestTable_RenderHTML_AutoIndex(t *testing.T) {
 	tw := NewWriter()
 	for rowIdx := 0; rowIdx < 3; rowIdx++ {
@@ -473,6 +501,175 @@ func TestTable_RenderHTML_ConvertColorsToSpans(t *testing.T) {
 	})
 }
 
+func TestTable_RenderHTML_InlineStyles(t *testing.T) {
+	t.Run("colors", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows[:2])
+		tw.AppendSection("The North", text.BgHiRed, text.FgWhite)
+		tw.AppendRow(Row{4, text.Colors{text.FgHiRed, text.Bold}.Sprint("Sansa"), text.Fg256Color(208).Sprint("Stark"), 1000})
+		tw.AppendFooter(testFooter)
+		tw.SetTitle(testTitle1)
+		tw.SetAutoIndex(true)
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Salary", Colors: text.Colors{text.FgRGB(255, 136, 0)}, VAlign: text.VAlignMiddle},
+		})
+		tw.SetStyle(StyleColoredBright)
+		tw.Style().HTML.InlineStyles = true
+
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table" style="border-collapse: collapse;">
+  <caption class="title" style="color: #29b8db; background-color: #666666; font-weight: bold;">Game of Thrones</caption>
+  <thead>
+  <tr>
+    <th style="background-color: #29b8db; color: #000000;">&nbsp;</th>
+    <th style="background-color: #29b8db; color: #000000; text-align: right;">#</th>
+    <th style="background-color: #29b8db; color: #000000;">First Name</th>
+    <th style="background-color: #29b8db; color: #000000;">Last Name</th>
+    <th style="background-color: #29b8db; color: #000000; text-align: right;">Salary</th>
+    <th style="background-color: #29b8db; color: #000000;">&nbsp;</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td style="background-color: #29b8db; color: #000000; text-align: right;">1</td>
+    <td style="background-color: #ffffff; color: #000000; text-align: right;">1</td>
+    <td style="background-color: #ffffff; color: #000000;">Arya</td>
+    <td style="background-color: #ffffff; color: #000000;">Stark</td>
+    <td style="color: #ff8800; text-align: right; vertical-align: middle;">3000</td>
+    <td style="background-color: #ffffff; color: #000000;">&nbsp;</td>
+  </tr>
+  <tr>
+    <td style="background-color: #29b8db; color: #000000; text-align: right;">2</td>
+    <td style="background-color: #e5e5e5; color: #000000; text-align: right;">20</td>
+    <td style="background-color: #e5e5e5; color: #000000;">Jon</td>
+    <td style="background-color: #e5e5e5; color: #000000;">Snow</td>
+    <td style="color: #ff8800; text-align: right; vertical-align: middle;">2000</td>
+    <td style="background-color: #e5e5e5; color: #000000;">You know nothing, Jon Snow!</td>
+  </tr>
+  <tr>
+    <th style="background-color: #f14c4c; color: #e5e5e5; text-align: left;" colspan=6>The North</th>
+  </tr>
+  <tr>
+    <td style="background-color: #29b8db; color: #000000; text-align: right;">3</td>
+    <td style="background-color: #ffffff; color: #000000; text-align: right;">4</td>
+    <td style="background-color: #ffffff; color: #000000;"><span style="font-weight: bold; color: #f14c4c;">Sansa</span></td>
+    <td style="background-color: #ffffff; color: #000000;"><span style="color: #ff6600;">Stark</span></td>
+    <td style="color: #ff8800; text-align: right; vertical-align: middle;">1000</td>
+    <td style="background-color: #ffffff; color: #000000;">&nbsp;</td>
+  </tr>
+  </tbody>
+  <tfoot>
+  <tr>
+    <td style="background-color: #11a8cd; color: #000000;">&nbsp;</td>
+    <td style="background-color: #11a8cd; color: #000000; text-align: right;">&nbsp;</td>
+    <td style="background-color: #11a8cd; color: #000000;">&nbsp;</td>
+    <td style="background-color: #11a8cd; color: #000000;">Total</td>
+    <td style="background-color: #11a8cd; color: #000000; text-align: right;">10000</td>
+    <td style="background-color: #11a8cd; color: #000000;">&nbsp;</td>
+  </tr>
+  </tfoot>
+</table>`)
+	})
+
+	t.Run("borders", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.AppendFooter(testFooter)
+		tw.SetStyle(StyleLight)
+		tw.Style().Color.Separator = text.Colors{text.FgHiBlack}
+		tw.Style().HTML.InlineStyles = true
+		tw.Style().Options.SeparateRows = true
+
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table" style="border-collapse: collapse; border: 1px solid #666666;">
+  <thead>
+  <tr>
+    <th style="text-align: right; border-bottom: 1px solid #666666;">#</th>
+    <th style="border-left: 1px solid #666666; border-bottom: 1px solid #666666;">First Name</th>
+    <th style="border-left: 1px solid #666666; border-bottom: 1px solid #666666;">Last Name</th>
+    <th style="text-align: right; border-left: 1px solid #666666; border-bottom: 1px solid #666666;">Salary</th>
+    <th style="border-left: 1px solid #666666; border-bottom: 1px solid #666666;">&nbsp;</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td style="text-align: right;">1</td>
+    <td style="border-left: 1px solid #666666;">Arya</td>
+    <td style="border-left: 1px solid #666666;">Stark</td>
+    <td style="text-align: right; border-left: 1px solid #666666;">3000</td>
+    <td style="border-left: 1px solid #666666;">&nbsp;</td>
+  </tr>
+  <tr>
+    <td style="text-align: right; border-top: 1px solid #666666;">20</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">Jon</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">Snow</td>
+    <td style="text-align: right; border-left: 1px solid #666666; border-top: 1px solid #666666;">2000</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">You know nothing, Jon Snow!</td>
+  </tr>
+  <tr>
+    <td style="text-align: right; border-top: 1px solid #666666;">300</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">Tyrion</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">Lannister</td>
+    <td style="text-align: right; border-left: 1px solid #666666; border-top: 1px solid #666666;">5000</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">&nbsp;</td>
+  </tr>
+  </tbody>
+  <tfoot>
+  <tr>
+    <td style="text-align: right; border-top: 1px solid #666666;">&nbsp;</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">&nbsp;</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">Total</td>
+    <td style="text-align: right; border-left: 1px solid #666666; border-top: 1px solid #666666;">10000</td>
+    <td style="border-left: 1px solid #666666; border-top: 1px solid #666666;">&nbsp;</td>
+  </tr>
+  </tfoot>
+</table>`)
+
+		tw.Style().Options = OptionsNoBordersAndSeparators
+		assert.NotContains(t, tw.RenderHTML(), "solid")
+	})
+}
+
+func TestTable_RenderHTML_RGBColors(t *testing.T) {
+	// render as if on a terminal without true-color support (no COLORTERM)
+	t.Setenv("COLORTERM", "")
+	text.SetColorDepthRGB(text.ColorDepth16)
+	defer text.SetColorDepthRGB(text.ColorDepthTrueColor)
+
+	tw := NewWriter()
+	tw.AppendRow(Row{text.FgRGB(255, 136, 0).Sprint("Arya"), 3000})
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 2, Colors: text.Colors{text.BgHex("#102030")}}})
+
+	// the terminal output falls back to the closest 16-color
+	compareOutputColored(t, tw.Render(), ""+
+		"+------+------+\n"+
+		"| \x1b[93mArya\x1b[0m |\x1b[40m 3000 \x1b[0m|\n"+
+		"+------+------+")
+	// but the HTML output has the exact colors
+	compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <tbody>
+  <tr>
+    <td><span style="color: #ff8800;">Arya</span></td>
+    <td align="right" style="background-color: #102030;">3000</td>
+  </tr>
+  </tbody>
+</table>`)
+
+	tw.Style().HTML.InlineStyles = true
+	compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table" style="border-collapse: collapse; border: 1px solid #d0d7de;">
+  <tbody>
+  <tr>
+    <td><span style="color: #ff8800;">Arya</span></td>
+    <td style="background-color: #102030; text-align: right; border-left: 1px solid #d0d7de;">3000</td>
+  </tr>
+  </tbody>
+</table>`)
+}
+
 func TestTable_RenderHTML_HiddenColumns(t *testing.T) {
 	tw := NewWriter()
 	tw.AppendHeader(testHeader)
@@ -26,32 +26,52 @@ func (t *Table) analyzeAndStringify(row Row, hint renderHint) rowStr {
 	rowOut := make(rowStr, len(row))
 	for colIdx, col := range row {
 		// if the column is not a number, keep track of it
-		if !hint.isHeaderRow && !hint.isFooterRow && !t.columnIsNonNumeric[colIdx] && !isNumber(col) {
+		if !hint.isHeaderRow && !hint.isFooterRow && !t.columnIsNonNumeric[colIdx] && !isNumber(cellValueOf(col)) {
 			t.columnIsNonNumeric[colIdx] = true
 		}
 
-		rowOut[colIdx] = t.analyzeAndStringifyColumn(colIdx, col, hint)
+		rowOut[colIdx] = t.analyzeAndStringifyColumn(row, colIdx, col, hint)
 	}
 	return rowOut
 }
 
-func (t *Table) analyzeAndStringifyColumn(colIdx int, col interface{}, hint renderHint) string {
+func (t *Table) analyzeAndStringifyColumn(row Row, colIdx int, col interface{}, hint renderHint) string {
 	// convert to a string and store it in the row
 	var colStr string
-	if transformer := t.getColumnTransformer(colIdx, hint); transformer != nil {
-		colStr = transformer(col)
-	} else if colStrVal, ok := col.(string); ok {
-		colStr = colStrVal
+	cr, isCellRenderer := col.(cellRenderer)
+	if isCellRenderer {
+		colStr = cr.renderCell(t, colIdx, hint)
+	} else if transformer := t.getColumnTransformerWithContext(colIdx, hint); transformer != nil {
+		colStr = transformer(t.getTransformContext(row, colIdx, hint))
 	} else {
-		colStr = convertValueToString(col)
+		colStr = t.stringifyValue(colIdx, col, hint)
 	}
 	colStr = strings.ReplaceAll(colStr, "\t", "    ")
 	colStr = text.ProcessCRLF(colStr)
 	// Avoid fmt.Sprintf when direction modifier is empty (most common case)
-	if t.directionModifier == "" {
-		return colStr
+	if t.directionModifier != "" {
+		colStr = t.directionModifier + colStr
+	}
+	// cell renderers generate HTML-safe content in HTML mode; remember where
+	// it is so that it doesn't get escaped all over again
+	if isCellRenderer && t.renderMode == renderModeHTML {
+		if t.htmlSafeCells == nil {
+			t.htmlSafeCells = make(map[htmlCellPosition]bool)
+		}
+		t.htmlSafeCells[newHTMLCellPosition(colIdx, hint)] = true
 	}
-	return t.directionModifier + colStr
+	return colStr
+}
+
+// stringifyValue converts a single value to a string using the column's
+// Transformer if one is available.
+func (t *Table) stringifyValue(colIdx int, val interface{}, hint renderHint) string {
+	if transformer := t.getColumnTransformer(colIdx, hint); transformer != nil {
+		return transformer(val)
+	} else if valStr, ok := val.(string); ok {
+		return valStr
+	}
+	return convertValueToString(val)
 }
 
 func (t *Table) extractMaxColumnLengths(rows []rowStr, hint renderHint) {
@@ -86,6 +106,45 @@ func (t *Table) extractMaxColumnLengthsFromRow(row rowStr, mci mergedColumnIndic
 	}
 }
 
+// decimalLengths contains the length of the integer and fraction parts of the
+// numbers in a column aligned with text.AlignDecimal.
+type decimalLengths struct {
+	integer  int
+	fraction int
+}
+
+// extractMaxDecimalLengths finds the longest integer and fraction parts in
+// each column aligned with text.AlignDecimal, and widens the column if needed
+// to fit both of them.
+func (t *Table) extractMaxDecimalLengths() {
+	t.maxDecimalLengths = make(map[int]decimalLengths)
+	for colIdx, cfg := range t.columnConfigMap {
+		if cfg.Align != text.AlignDecimal || colIdx >= t.numColumns {
+			continue
+		}
+
+		var lengths decimalLengths
+		for _, row := range t.rows {
+			if colIdx >= len(row) {
+				continue
+			}
+			for _, line := range strings.Split(row[colIdx], "\n") {
+				integer, fraction := text.DecimalLengths(line, cfg.DecimalSeparator)
+				if integer > lengths.integer {
+					lengths.integer = integer
+				}
+				if fraction > lengths.fraction {
+					lengths.fraction = fraction
+				}
+			}
+		}
+		t.maxDecimalLengths[colIdx] = lengths
+		if length := lengths.integer + lengths.fraction; length > t.maxColumnLengths[colIdx] {
+			t.maxColumnLengths[colIdx] = length
+		}
+	}
+}
+
 // reBalanceMaxMergedColumnLengths tries to re-balance the merged column lengths
 // across all columns. It does this from the lowest end index to the highest,
 // and within that set from the highest start index to the lowest. It
@@ -159,6 +218,13 @@ func (t *Table) initForRender(mode renderMode) {
 	// pick a default style if none was set until now
 	t.Style()
 
+	// fail loudly on the settings that would otherwise be ignored silently
+	if t.strictMode {
+		if err := t.Validate(); err != nil {
+			panic(err)
+		}
+	}
+
 	// reset rendering state
 	t.reset()
 
@@ -185,6 +251,8 @@ func (t *Table) initForRender(mode renderMode) {
 
 func (t *Table) initForRenderColumnConfigs() {
 	t.columnConfigMap = map[int]ColumnConfig{}
+	t.virtualColumnNames = nil
+	numColumnsRaw := t.getNumColumnsRaw()
 	for _, colCfg := range t.columnConfigs {
 		// find the column number if none provided; this logic can work only if
 		// a header row is present and has a column with the given name
@@ -196,18 +264,100 @@ func (t *Table) initForRenderColumnConfigs() {
 				}
 			}
 		}
+		// a computed column not found in the header is a virtual column to be
+		// added after all the other columns
+		if colCfg.Number == 0 && colCfg.Compute != nil && colCfg.Name != "" {
+			if t.virtualColumnNames == nil {
+				t.virtualColumnNames = make(map[int]string)
+			}
+			colCfg.Number = numColumnsRaw + len(t.virtualColumnNames) + 1
+			t.virtualColumnNames[colCfg.Number-1] = colCfg.Name
+		}
 		if colCfg.Number > 0 {
 			t.columnConfigMap[colCfg.Number-1] = colCfg
 		}
 	}
 }
 
+// initForRenderComputedColumns returns a copy of the row with the values of
+// the computed columns (in colIndices, sorted) filled in.
+func (t *Table) initForRenderComputedColumns(row Row, colIndices []int) Row {
+	numColumns := len(row)
+	if len(colIndices) > 0 && colIndices[len(colIndices)-1] >= numColumns {
+		numColumns = colIndices[len(colIndices)-1] + 1
+	}
+	rowCopy := make(Row, len(row), numColumns)
+	copy(rowCopy, row)
+	for _, colIdx := range colIndices {
+		for len(rowCopy) <= colIdx {
+			rowCopy = append(rowCopy, "")
+		}
+		rowCopy[colIdx] = t.columnConfigMap[colIdx].Compute(rowCopy)
+	}
+	return rowCopy
+}
+
+// initForRenderRowsFooterRaw returns the footer rows with the values of the
+// columns with ComputeFooter filled in the first one.
+func (t *Table) initForRenderRowsFooterRaw() []Row {
+	var colIndices []int
+	for colIdx, colCfg := range t.columnConfigMap {
+		if colCfg.ComputeFooter != nil {
+			colIndices = append(colIndices, colIdx)
+		}
+	}
+	if len(colIndices) == 0 {
+		return t.rowsFooterRaw
+	}
+	sort.Ints(colIndices)
+
+	rows := make([]Row, len(t.rowsFooterRaw))
+	copy(rows, t.rowsFooterRaw)
+	if len(rows) == 0 {
+		rows = append(rows, Row{})
+	}
+	rows[0] = append(Row{}, rows[0]...)
+	rowsVisible := t.getRowsVisibleRaw()
+	for _, colIdx := range colIndices {
+		for len(rows[0]) <= colIdx {
+			rows[0] = append(rows[0], "")
+		}
+		colCfg := t.columnConfigMap[colIdx]
+		if colCfg.ComputeFooterFromVisibleRows {
+			rows[0][colIdx] = colCfg.ComputeFooter(rowsVisible)
+		} else {
+			rows[0][colIdx] = colCfg.ComputeFooter(t.rowsRawFiltered)
+		}
+	}
+	return rows
+}
+
+// initForRenderRowsHeaderRaw returns the header rows with the names of the
+// virtual columns added to the first one.
+func (t *Table) initForRenderRowsHeaderRaw() []Row {
+	if len(t.virtualColumnNames) == 0 || len(t.rowsHeaderRaw) == 0 {
+		return t.rowsHeaderRaw
+	}
+
+	rows := make([]Row, len(t.rowsHeaderRaw))
+	copy(rows, t.rowsHeaderRaw)
+	rows[0] = append(Row{}, rows[0]...)
+	for colIdx, name := range t.virtualColumnNames {
+		for len(rows[0]) <= colIdx {
+			rows[0] = append(rows[0], "")
+		}
+		rows[0][colIdx] = name
+	}
+	return rows
+}
+
 func (t *Table) initForRenderColumnLengths() {
 	t.maxColumnLengths = make([]int, t.numColumns)
 	t.maxMergedColumnLengths = make(map[int]map[int]int)
 	t.extractMaxColumnLengths(t.rowsHeader, renderHint{isHeaderRow: true})
 	t.extractMaxColumnLengths(t.rows, renderHint{})
 	t.extractMaxColumnLengths(t.rowsFooter, renderHint{isFooterRow: true})
+	t.extractMaxDecimalLengths()
 
 	// increase the column lengths if any are under the limits
 	for colIdx := range t.maxColumnLengths {
@@ -219,9 +369,11 @@ func (t *Table) initForRenderColumnLengths() {
 	t.reBalanceMaxMergedColumnLengths()
 }
 
-func (t *Table) initForRenderHideColumns() {
+// initForRenderHideColumns strips out the hidden columns, and returns a map of
+// the old column indices to the new ones (nil if no columns were hidden).
+func (t *Table) initForRenderHideColumns() map[int]int {
 	if !t.hasHiddenColumns() {
-		return
+		return nil
 	}
 	colIdxMap := t.hideColumns()
 
@@ -242,6 +394,7 @@ func (t *Table) initForRenderHideColumns() {
 		}
 	}
 	t.columnConfigMap = columnConfigMap
+	return colIdxMap
 }
 
 func (t *Table) initForRenderMaxRowLength() {
@@ -267,7 +420,16 @@ func (t *Table) initForRenderMaxRowLength() {
 }
 
 func (t *Table) initForRenderPaddedColumns() {
-	paddingSize := t.style.Size.WidthMin - t.maxRowLength
+	widthMin := t.style.Size.WidthMin
+	// make room for the text of the omitted rows and the section titles to
+	// fit in one line
+	if widthOmitted := t.getRowsOmittedWidth(); widthOmitted > widthMin {
+		widthMin = widthOmitted
+	}
+	if widthSections := t.getRowSectionsWidth(); widthSections > widthMin {
+		widthMin = widthSections
+	}
+	paddingSize := widthMin - t.maxRowLength
 	for paddingSize > 0 {
 		// distribute padding equally among all columns
 		numColumnsPadded := 0
@@ -275,6 +437,7 @@ func (t *Table) initForRenderPaddedColumns() {
 			colWidthMax := t.getColumnWidthMax(colIdx)
 			if colWidthMax == 0 || t.maxColumnLengths[colIdx] < colWidthMax {
 				t.maxColumnLengths[colIdx]++
+				t.maxRowLength++
 				numColumnsPadded++
 				paddingSize--
 			}
@@ -298,20 +461,72 @@ func (t *Table) initForRenderRows() {
 	// stringify the filtered rows
 	t.numColumns = 0
 	t.rows = t.initForRenderRowsStringify(t.rowsRawFiltered, renderHint{})
-	t.rowsFooter = t.initForRenderRowsStringify(t.rowsFooterRaw, renderHint{isFooterRow: true})
-	t.rowsHeader = t.initForRenderRowsStringify(t.rowsHeaderRaw, renderHint{isHeaderRow: true})
+	t.rowsHeader = t.initForRenderRowsStringify(t.initForRenderRowsHeaderRaw(), renderHint{isHeaderRow: true})
 
 	// sort the rows as requested
 	t.initForRenderSortRows()
 
+	// skip/leave out the rows as per Offset and Limit, and compute the footer
+	// from either all or just the visible rows
+	t.initForRenderLimitRows()
+	t.rowsFooter = t.initForRenderRowsStringify(t.initForRenderRowsFooterRaw(), renderHint{isFooterRow: true})
+
+	// find the rows to render the sections and notes around
+	t.initForRenderSections()
+
 	// find the row colors (if any)
 	t.initForRenderRowPainterColors()
 
 	// suppress columns without any content
 	t.initForRenderSuppressColumns()
 
-	// strip out hidden columns
-	t.initForRenderHideColumns()
+	// strip out hidden columns, and add the column group rows to the header
+	// (after sorting, which looks up the column names in the first header row)
+	colIdxMap := t.initForRenderHideColumns()
+	t.initForRenderColumnGroups(colIdxMap)
+
+	// move the HTML-safe cells to where they are in the rows being rendered
+	t.initForRenderHTMLSafeCells(colIdxMap)
+}
+
+// initForRenderHTMLSafeCells moves the HTML-safe cells from their positions
+// in the stringified rows to their positions in the rows being rendered, after
+// the rows have been sorted and limited, and the columns hidden.
+func (t *Table) initForRenderHTMLSafeCells(colIdxMap map[int]int) {
+	if len(t.htmlSafeCells) == 0 {
+		return
+	}
+
+	rowIdxMap := make(map[int]int, len(t.rows))
+	for rowIdx := range t.rows {
+		if t.sortedRowIndices != nil {
+			rowIdxMap[t.sortedRowIndices[rowIdx]] = rowIdx
+		} else {
+			rowIdxMap[rowIdx] = rowIdx
+		}
+	}
+
+	htmlSafeCells := make(map[htmlCellPosition]bool, len(t.htmlSafeCells))
+	for pos := range t.htmlSafeCells {
+		if colIdxMap != nil {
+			colIdx, ok := colIdxMap[pos.colIdx]
+			if !ok { // hidden column
+				continue
+			}
+			pos.colIdx = colIdx
+		}
+		if pos.isHeaderRow {
+			pos.rowIdx += len(t.columnGroupRows)
+		} else if !pos.isFooterRow {
+			rowIdx, ok := rowIdxMap[pos.rowIdx]
+			if !ok { // row left out as per Limit/Offset, or collapsed
+				continue
+			}
+			pos.rowIdx = rowIdx
+		}
+		htmlSafeCells[pos] = true
+	}
+	t.htmlSafeCells = htmlSafeCells
 }
 
 // initForRenderFilterRows filters the raw rows by removing non-matching rows from t.rowsRawFiltered.
@@ -319,10 +534,9 @@ func (t *Table) initForRenderFilterRows() {
 	// Restore original rows before filtering (in case of multiple renders with different filters)
 	if len(t.rowsRaw) > 0 {
 		t.rowsRawFiltered = make([]Row, len(t.rowsRaw))
+		computedColumns := t.getComputedColumnIndices()
 		for i, row := range t.rowsRaw {
-			rowCopy := make(Row, len(row))
-			copy(rowCopy, row)
-			t.rowsRawFiltered[i] = rowCopy
+			t.rowsRawFiltered[i] = t.initForRenderComputedColumns(row, computedColumns)
 		}
 	}
 
@@ -345,16 +559,23 @@ func (t *Table) initForRenderFilterRows() {
 		return
 	}
 
-	// Filter rows in place and track which original rows were kept
+	// Filter rows in place and track which original rows were kept; the
+	// ancestors of the matching child rows are kept as well
+	matches := make([]bool, len(t.rowsRawFiltered))
+	for origIdx, row := range t.rowsRawFiltered {
+		matches[origIdx] = t.matchesFiltersRaw(row, parsedFilterBy)
+	}
+	t.matchTreeAncestors(matches)
 	filteredRows := t.rowsRawFiltered[:0]
 	keptIndices := make([]int, 0, len(t.rowsRawFiltered))
 	for origIdx, row := range t.rowsRawFiltered {
-		if t.matchesFiltersRaw(row, parsedFilterBy) {
+		if matches[origIdx] {
 			filteredRows = append(filteredRows, row)
 			keptIndices = append(keptIndices, origIdx)
 		}
 	}
 	t.rowsRawFiltered = filteredRows
+	t.rowsRawFilteredIndices = keptIndices
 
 	// Update separators map to reflect filtered rows
 	if len(originalSeparators) > 0 {
@@ -472,14 +693,22 @@ func (t *Table) initForRenderRowSeparatorStrings() {
 }
 
 func (t *Table) initForRenderSortRows() {
-	if len(t.sortBy) == 0 {
+	if len(t.sortBy) == 0 && len(t.rowsParentMap) == 0 {
 		return
 	}
 
 	// sort the rows
-	t.sortedRowIndices = t.getSortedRowIndices()
-	sortedRows := make([]rowStr, len(t.rows))
-	for idx := range t.rows {
+	if len(t.sortBy) > 0 {
+		t.sortedRowIndices = t.getSortedRowIndices()
+		t.sortWithinSections(t.sortedRowIndices)
+	}
+	// arrange the child rows under their parents in the sorted order; this
+	// may leave out the rows that are collapsed
+	if len(t.rowsParentMap) > 0 {
+		t.sortedRowIndices = t.initForRenderTreeRows(t.sortedRowIndices)
+	}
+	sortedRows := make([]rowStr, len(t.sortedRowIndices))
+	for idx := range sortedRows {
 		sortedRows[idx] = t.rows[t.sortedRowIndices[idx]]
 	}
 	t.rows = sortedRows
@@ -519,16 +748,25 @@ func (t *Table) initForRenderSuppressColumns() {
 func (t *Table) reset() {
 	t.autoIndexVIndexMaxLength = 0
 	t.columnConfigMap = nil
+	t.columnGroupRows = nil
 	t.columnIsNonNumeric = nil
 	t.firstRowOfPage = true
+	t.htmlSafeCells = nil
 	t.maxColumnLengths = nil
+	t.maxDecimalLengths = nil
 	t.maxRowLength = 0
 	t.numColumns = 0
 	t.numLinesRendered = 0
 	t.rowSeparators = nil
 	t.rows = nil
 	t.rowsColors = nil
+	t.rowsNotes = nil
+	t.rowsOmitted = 0
+	t.rowsOmittedIdx = 0
+	t.rowsSections = nil
+	t.rowsRawFilteredIndices = nil
 	t.rowsFooter = nil
 	t.rowsHeader = nil
+	t.separatorsRendered = nil
 	t.sortedRowIndices = nil
 }
@@ -39,7 +39,7 @@ func (t *Table) markdownRenderCaption(out *strings.Builder) {
 
 func (t *Table) markdownRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
 	// when working on line number 2 or more, insert a newline first
-	if out.Len() > 0 {
+	if t.hasRenderedOutput(out) {
 		out.WriteRune('\n')
 	}
 
@@ -68,6 +68,14 @@ func (t *Table) markdownRenderRow(out *strings.Builder, row rowStr, hint renderH
 	}
 }
 
+// markdownRenderRowAnnotation renders a section or a note in the first column
+// of a row of its own.
+func (t *Table) markdownRenderRowAnnotation(out *strings.Builder, annotation string) {
+	row := make(rowStr, t.numColumns)
+	row[0] = annotation
+	t.markdownRenderRow(out, row, renderHint{isAnnotationRow: true})
+}
+
 func (t *Table) markdownRenderRowAutoIndex(out *strings.Builder, colIdx int, hint renderHint) {
 	if colIdx == 0 && t.autoIndex {
 		if hint.isSeparatorRow {
@@ -77,7 +85,7 @@ func (t *Table) markdownRenderRowAutoIndex(out *strings.Builder, colIdx int, hin
 				out.WriteRune(' ')
 				out.WriteString("---:")
 			}
-		} else if hint.isRegularRow() {
+		} else if hint.isRegularRow() && !hint.isAnnotationRow {
 			if t.style.Markdown.PadContent {
 				rowNumStr := fmt.Sprint(hint.rowNumber)
 				out.WriteRune(' ')
@@ -100,12 +108,33 @@ func (t *Table) markdownRenderRowAutoIndex(out *strings.Builder, colIdx int, hin
 	}
 }
 
+func (t *Table) markdownRenderRowNotes(out *strings.Builder, notes []string) {
+	for _, note := range notes {
+		t.markdownRenderRowAnnotation(out, "_"+note+"_")
+	}
+}
+
 func (t *Table) markdownRenderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
 	if len(rows) > 0 {
 		for idx, row := range rows {
+			if !t.renderToFlush(out) {
+				return
+			}
 			hint.rowNumber = idx + 1
+			if idx == 0 {
+				t.markdownRenderRowNotes(out, t.getRowNotes(-1, hint))
+			}
+			t.markdownRenderRowsOmitted(out, t.getRowsOmitted(idx, hint))
+			if section := t.getRowSection(idx, hint); section != nil {
+				t.markdownRenderRowAnnotation(out, "**"+section.title+"**")
+				t.markdownRenderRowNotes(out, section.notes)
+			}
 			t.markdownRenderRow(out, row, hint)
+			t.markdownRenderRowNotes(out, t.getRowNotes(idx, hint))
 
+			if idx == len(rows)-1 {
+				t.markdownRenderRowsOmitted(out, t.getRowsOmitted(len(rows), hint))
+			}
 			if idx == len(rows)-1 && hint.isHeaderRow {
 				t.markdownRenderSeparator(out, renderHint{isSeparatorRow: true})
 			}
@@ -113,6 +142,12 @@ func (t *Table) markdownRenderRows(out *strings.Builder, rows []rowStr, hint ren
 	}
 }
 
+func (t *Table) markdownRenderRowsOmitted(out *strings.Builder, numRows int) {
+	if numRows > 0 {
+		t.markdownRenderRowAnnotation(out, "_"+t.getRowsOmittedText(numRows)+"_")
+	}
+}
+
 func (t *Table) markdownRenderRowsFooter(out *strings.Builder) {
 	t.markdownRenderRows(out, t.rowsFooter, renderHint{isFooterRow: true})
 }
@@ -127,7 +162,7 @@ func (t *Table) markdownRenderRowsHeader(out *strings.Builder) {
 
 func (t *Table) markdownRenderSeparator(out *strings.Builder, hint renderHint) {
 	// when working on line number 2 or more, insert a newline first
-	if out.Len() > 0 {
+	if t.hasRenderedOutput(out) {
 		out.WriteRune('\n')
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -89,6 +90,22 @@ func TestTable_RenderMarkdown_Padded(t *testing.T) {
 _A Song of Ice and Fire_`)
 }
 
+func TestTable_RenderMarkdown_AlignDecimal(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Item", "Price"})
+	tw.AppendRows([]Row{{"Apple", 3}, {"Banana", 12.5}, {"Cherry", 1000.125}})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Price", Align: text.AlignDecimal}})
+	tw.Style().Markdown.PadContent = true
+
+	// Markdown cannot align on the decimal separator; right-align instead
+	compareOutput(t, tw.RenderMarkdown(), `
+| Item   |    Price |
+| ------ | --------:|
+| Apple  |        3 |
+| Banana |     12.5 |
+| Cherry | 1000.125 |`)
+}
+
 func TestTable_RenderMarkdown_Padded_AutoIndex(t *testing.T) {
 	tw := NewWriter()
 	for rowIdx := 0; rowIdx < 10; rowIdx++ {
@@ -0,0 +1,365 @@
+package table
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// SQL related constants
+const (
+	sqlBatchSizeDefault   = 100
+	sqlTimeFormat         = "2006-01-02 15:04:05.999999-07:00"
+	sqlTimeFormatNoOffset = "2006-01-02 15:04:05.999999"
+)
+
+// sqlType is the type of a column as inferred from its values.
+type sqlType int
+
+const (
+	sqlTypeText sqlType = iota
+	sqlTypeInteger
+	sqlTypeReal
+	sqlTypeBoolean
+	sqlTypeTimestamp
+	sqlTypeBinary
+)
+
+// RenderSQL renders the Table as SQL statements that populate the table with
+// the given name, using the syntax of the given dialect. Example (for
+// SQLDialectPostgres, with Style().SQL.CreateTable set):
+//
+//	CREATE TABLE "employees" (
+//	  "id" BIGINT,
+//	  "first_name" TEXT,
+//	  "last_name" TEXT,
+//	  "salary" BIGINT,
+//	  "column_5" TEXT
+//	);
+//	INSERT INTO "employees" ("id", "first_name", "last_name", "salary", "column_5") VALUES
+//	  (1, 'Arya', 'Stark', 3000, NULL),
+//	  (20, 'Jon', 'Snow', 2000, 'You know nothing, Jon Snow!'),
+//	  (300, 'Tyrion', 'Lannister', 5000, NULL);
+//
+// The values are rendered from the raw values in the rows (ignoring the
+// Transformers) with nil as NULL and []byte as binary (hex) literals, and the
+// column types are inferred from them.
+// The column names are taken from the first Header row (or named A, B, C,
+// etc.) and sanitized into identifiers. The title and caption are rendered
+// as comments, while the Footer rows are left out.
+func (t *Table) RenderSQL(tableName string, dialect SQLDialect) string {
+	t.initForRender(renderModeSQL)
+
+	var out strings.Builder
+	if t.numColumns > 0 {
+		out.Grow(t.estimatedRenderLength())
+		if t.title != "" {
+			t.sqlRenderComment(&out, t.title)
+		}
+
+		columns, rows := t.sqlColumns(), t.getRowsVisibleRaw()
+		tableName = sqlQuoteIdentifier(sqlSanitizeIdentifier(tableName, "data"), dialect)
+		if t.style.SQL.CreateTable {
+			t.sqlRenderCreateTable(&out, tableName, columns, rows, dialect)
+		}
+		t.sqlRenderInserts(&out, tableName, columns, rows, dialect)
+
+		if t.caption != "" {
+			t.sqlRenderComment(&out, t.caption)
+		}
+	}
+	return t.render(&out)
+}
+
+// sqlColumn is a column to be rendered by RenderSQL.
+type sqlColumn struct {
+	colIdx int
+	name   string
+}
+
+// sqlColumns returns the columns that are not hidden, with the names sanitized
+// into unique identifiers.
+func (t *Table) sqlColumns() []sqlColumn {
+	// the column configs got re-mapped to the rendered columns after leaving
+	// out the hidden ones; map them back to the raw columns
+	t.initForRenderColumnConfigs()
+
+	var header Row
+	if rowsHeader := t.initForRenderRowsHeaderRaw(); len(rowsHeader) > 0 {
+		header = rowsHeader[0]
+	}
+	numColumns := len(header)
+	for _, row := range t.rowsRawFiltered {
+		if len(row) > numColumns {
+			numColumns = len(row)
+		}
+	}
+
+	var columns []sqlColumn
+	names := make(map[string]bool)
+	for colIdx := 0; colIdx < numColumns; colIdx++ {
+		if t.columnConfigMap[colIdx].Hidden {
+			continue
+		}
+		name := AutoIndexColumnID(colIdx)
+		if header != nil {
+			name = ""
+			if colIdx < len(header) {
+				name = fmt.Sprint(header[colIdx])
+			}
+		}
+		name = sqlSanitizeIdentifier(name, fmt.Sprintf("column_%d", colIdx+1))
+		for suffix, baseName := 2, name; names[name]; suffix++ {
+			name = fmt.Sprintf("%s_%d", baseName, suffix)
+		}
+		names[name] = true
+		columns = append(columns, sqlColumn{colIdx: colIdx, name: name})
+	}
+	return columns
+}
+
+func (t *Table) sqlRenderComment(out *strings.Builder, comment string) {
+	for _, line := range strings.Split(comment, "\n") {
+		t.sqlRenderLine(out, strings.TrimRightFunc("-- "+line, unicode.IsSpace))
+	}
+}
+
+func (t *Table) sqlRenderCreateTable(out *strings.Builder, tableName string, columns []sqlColumn, rows []Row, dialect SQLDialect) {
+	t.sqlRenderLine(out, "CREATE TABLE "+tableName+" (")
+	for idx, column := range columns {
+		line := "  " + sqlQuoteIdentifier(column.name, dialect) + " " +
+			sqlTypeName(sqlInferType(rows, column.colIdx), dialect)
+		if idx < len(columns)-1 {
+			line += ","
+		}
+		t.sqlRenderLine(out, line)
+	}
+	t.sqlRenderLine(out, ");")
+}
+
+func (t *Table) sqlRenderInserts(out *strings.Builder, tableName string, columns []sqlColumn, rows []Row, dialect SQLDialect) {
+	names := make([]string, len(columns))
+	for idx, column := range columns {
+		names[idx] = sqlQuoteIdentifier(column.name, dialect)
+	}
+	insert := "INSERT INTO " + tableName + " (" + strings.Join(names, ", ") + ") VALUES"
+
+	batchSize := t.style.SQL.getBatchSize()
+	values := make([]string, len(columns))
+	for rowIdx, row := range rows {
+		if !t.renderToFlush(out) {
+			return
+		}
+		if rowIdx%batchSize == 0 {
+			t.sqlRenderLine(out, insert)
+		}
+		for idx, column := range columns {
+			var val interface{}
+			if column.colIdx < len(row) {
+				val = cellValueOf(row[column.colIdx])
+			}
+			values[idx] = sqlValue(val, dialect)
+		}
+
+		line := "  (" + strings.Join(values, ", ") + ")"
+		if rowIdx%batchSize == batchSize-1 || rowIdx == len(rows)-1 {
+			line += ";"
+		} else {
+			line += ","
+		}
+		t.sqlRenderLine(out, line)
+	}
+}
+
+func (t *Table) sqlRenderLine(out *strings.Builder, line string) {
+	if t.hasRenderedOutput(out) {
+		out.WriteRune('\n')
+	}
+	out.WriteString(line)
+}
+
+// sqlInferType returns the type of the column based on the (non-nil) values
+// in it; columns with a mix of types are treated as text.
+func sqlInferType(rows []Row, colIdx int) sqlType {
+	colType, found := sqlTypeText, false
+	for _, row := range rows {
+		if colIdx >= len(row) {
+			continue
+		}
+		val := cellValueOf(row[colIdx])
+		if val == nil {
+			continue
+		}
+
+		valType := sqlTypeText
+		switch reflect.TypeOf(val).Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			valType = sqlTypeInteger
+		case reflect.Float32, reflect.Float64:
+			valType = sqlTypeReal
+		case reflect.Bool:
+			valType = sqlTypeBoolean
+		}
+		switch val.(type) {
+		case time.Time:
+			valType = sqlTypeTimestamp
+		case []byte:
+			valType = sqlTypeBinary
+		}
+
+		switch {
+		case !found:
+			colType, found = valType, true
+		case colType == valType:
+		case (colType == sqlTypeInteger && valType == sqlTypeReal) ||
+			(colType == sqlTypeReal && valType == sqlTypeInteger):
+			colType = sqlTypeReal
+		default:
+			return sqlTypeText
+		}
+	}
+	return colType
+}
+
+// sqlQuoteIdentifier quotes the (table/column) name as per the dialect.
+func sqlQuoteIdentifier(name string, dialect SQLDialect) string {
+	if dialect == SQLDialectMySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// sqlQuoteString quotes the string as per the dialect; MySQL treats the
+// backslash as an escape character by default, while the others don't.
+// Postgres does not allow NUL in strings at all, so a string with one is
+// written as an escape string literal with the NUL escaped, which Postgres
+// rejects with an error instead of the NUL ending up in the SQL as is.
+func sqlQuoteString(str string, dialect SQLDialect) string {
+	switch {
+	case dialect == SQLDialectMySQL:
+		str = strings.NewReplacer(
+			`\`, `\\`,
+			"\x00", `\0`,
+			"\n", `\n`,
+			"\r", `\r`,
+			"\x1a", `\Z`,
+		).Replace(str)
+	case dialect == SQLDialectPostgres && strings.ContainsRune(str, 0):
+		return "E'" + strings.NewReplacer(
+			`\`, `\\`,
+			"'", "''",
+			"\x00", `\000`,
+		).Replace(str) + "'"
+	}
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
+
+// sqlSanitizeIdentifier converts the name into a lower-case identifier made
+// of letters, digits and underscores (ex.: "First Name" to "first_name"), or
+// returns the fallback if nothing remains of it.
+func sqlSanitizeIdentifier(name string, fallback string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "_") {
+			sb.WriteRune('_')
+		}
+	}
+	identifier := strings.TrimSuffix(sb.String(), "_")
+	if identifier == "" {
+		return fallback
+	}
+	if identifier[0] >= '0' && identifier[0] <= '9' {
+		identifier = "_" + identifier
+	}
+	return identifier
+}
+
+// sqlTypeName returns the name of the type as per the dialect.
+func sqlTypeName(colType sqlType, dialect SQLDialect) string {
+	switch dialect {
+	case SQLDialectMySQL:
+		switch colType {
+		case sqlTypeInteger:
+			return "BIGINT"
+		case sqlTypeReal:
+			return "DOUBLE"
+		case sqlTypeBoolean:
+			return "BOOLEAN"
+		case sqlTypeTimestamp:
+			return "DATETIME(6)"
+		case sqlTypeBinary:
+			return "LONGBLOB"
+		}
+	case SQLDialectPostgres:
+		switch colType {
+		case sqlTypeInteger:
+			return "BIGINT"
+		case sqlTypeReal:
+			return "DOUBLE PRECISION"
+		case sqlTypeBoolean:
+			return "BOOLEAN"
+		case sqlTypeTimestamp:
+			return "TIMESTAMP WITH TIME ZONE"
+		case sqlTypeBinary:
+			return "BYTEA"
+		}
+	default:
+		switch colType {
+		case sqlTypeInteger, sqlTypeBoolean:
+			return "INTEGER"
+		case sqlTypeReal:
+			return "REAL"
+		case sqlTypeBinary:
+			return "BLOB"
+		}
+	}
+	return "TEXT"
+}
+
+// sqlValue returns the value as a literal as per the dialect.
+func sqlValue(val interface{}, dialect SQLDialect) string {
+	switch v := val.(type) {
+	case nil:
+		return "NULL"
+	case time.Time:
+		if dialect == SQLDialectMySQL {
+			return sqlQuoteString(v.UTC().Format(sqlTimeFormatNoOffset), dialect)
+		}
+		return sqlQuoteString(v.Format(sqlTimeFormat), dialect)
+	case []byte:
+		if dialect == SQLDialectPostgres {
+			return `'\x` + hex.EncodeToString(v) + "'"
+		}
+		return "X'" + hex.EncodeToString(v) + "'"
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Bool:
+		if dialect != SQLDialectMySQL && dialect != SQLDialectPostgres {
+			if rv.Bool() {
+				return "1"
+			}
+			return "0"
+		}
+		return strings.ToUpper(strconv.FormatBool(rv.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(rv.Float()) || math.IsInf(rv.Float(), 0) {
+			return "NULL"
+		}
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	}
+	return sqlQuoteString(convertValueToString(val), dialect)
+}
@@ -0,0 +1,159 @@
+package table
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_RenderSQL(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(Row{0, "Winter", "Is", 0, "Coming.\nThe North Remembers!"})
+	tw.AppendRow(Row{400, "O'Brien", `C:\Users`, 1500.5})
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+	tw.Style().SQL.CreateTable = true
+
+	t.Run("sqlite", func(t *testing.T) {
+		compareOutput(t, tw.RenderSQL("Game of Thrones", SQLDialectSQLite), `
+-- Game of Thrones
+CREATE TABLE "game_of_thrones" (
+  "column_1" INTEGER,
+  "first_name" TEXT,
+  "last_name" TEXT,
+  "salary" REAL,
+  "column_5" TEXT
+);
+INSERT INTO "game_of_thrones" ("column_1", "first_name", "last_name", "salary", "column_5") VALUES
+  (1, 'Arya', 'Stark', 3000, NULL),
+  (20, 'Jon', 'Snow', 2000, 'You know nothing, Jon Snow!'),
+  (300, 'Tyrion', 'Lannister', 5000, NULL),
+  (0, 'Winter', 'Is', 0, 'Coming.
+The North Remembers!'),
+  (400, 'O''Brien', 'C:\Users', 1500.5, NULL);
+-- A Song of Ice and Fire`)
+	})
+
+	t.Run("mysql", func(t *testing.T) {
+		compareOutput(t, tw.RenderSQL("got", SQLDialectMySQL), `
+-- Game of Thrones
+CREATE TABLE `+"`got`"+` (
+  `+"`column_1`"+` BIGINT,
+  `+"`first_name`"+` TEXT,
+  `+"`last_name`"+` TEXT,
+  `+"`salary`"+` DOUBLE,
+  `+"`column_5`"+` TEXT
+);
+INSERT INTO `+"`got` (`column_1`, `first_name`, `last_name`, `salary`, `column_5`)"+` VALUES
+  (1, 'Arya', 'Stark', 3000, NULL),
+  (20, 'Jon', 'Snow', 2000, 'You know nothing, Jon Snow!'),
+  (300, 'Tyrion', 'Lannister', 5000, NULL),
+  (0, 'Winter', 'Is', 0, 'Coming.\nThe North Remembers!'),
+  (400, 'O''Brien', 'C:\\Users', 1500.5, NULL);
+-- A Song of Ice and Fire`)
+	})
+
+	t.Run("postgres in batches", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"ID", "Name", "Name", "Active", "Joined", "Score", "Misc"})
+		joined := time.Date(2020, 1, 2, 3, 4, 5, 600000000, time.FixedZone("IST", 19800))
+		tw.AppendRows([]Row{
+			{1, "Arya", "Stark", true, joined, math.NaN(), 1},
+			{2, "Jon", nil, false, joined.AddDate(1, 0, 0), float32(0.1), "one"},
+			{uint8(3), "Tyrion", "Lannister", nil, nil, 1e21},
+		})
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Misc", Hidden: true}})
+		tw.Style().SQL = SQLOptions{BatchSize: 2, CreateTable: true}
+
+		compareOutput(t, tw.RenderSQL("", SQLDialectPostgres), `
+CREATE TABLE "data" (
+  "id" BIGINT,
+  "name" TEXT,
+  "name_2" TEXT,
+  "active" BOOLEAN,
+  "joined" TIMESTAMP WITH TIME ZONE,
+  "score" DOUBLE PRECISION
+);
+INSERT INTO "data" ("id", "name", "name_2", "active", "joined", "score") VALUES
+  (1, 'Arya', 'Stark', TRUE, '2020-01-02 03:04:05.6+05:30', NULL),
+  (2, 'Jon', NULL, FALSE, '2021-01-02 03:04:05.6+05:30', 0.1);
+INSERT INTO "data" ("id", "name", "name_2", "active", "joined", "score") VALUES
+  (3, 'Tyrion', 'Lannister', NULL, NULL, 1e+21);`)
+		assert.Contains(t, tw.RenderSQL("t", SQLDialectMySQL), "'2020-01-01 21:34:05.6'")
+		assert.Contains(t, tw.RenderSQL("t", SQLDialectSQLite), "(1, 'Arya', 'Stark', 1, ")
+	})
+
+	t.Run("binary and nul", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Data", "Text"})
+		tw.AppendRows([]Row{{[]byte("hi"), "a\x00b\\c'd"}, {[]byte{}, "e\\f"}, {nil, nil}})
+		tw.Style().SQL.CreateTable = true
+
+		compareOutput(t, tw.RenderSQL("t", SQLDialectPostgres), `
+CREATE TABLE "t" (
+  "data" BYTEA,
+  "text" TEXT
+);
+INSERT INTO "t" ("data", "text") VALUES
+  ('\x6869', E'a\000b\\c''d'),
+  ('\x', 'e\f'),
+  (NULL, NULL);`)
+		compareOutput(t, tw.RenderSQL("t", SQLDialectMySQL), `
+CREATE TABLE `+"`t` (\n  `data` LONGBLOB,\n  `text` TEXT\n);"+`
+INSERT INTO `+"`t` (`data`, `text`)"+` VALUES
+  (X'6869', 'a\0b\\c''d'),
+  (X'', 'e\\f'),
+  (NULL, NULL);`)
+		compareOutput(t, tw.RenderSQL("t", SQLDialectSQLite), `
+CREATE TABLE "t" (
+  "data" BLOB,
+  "text" TEXT
+);
+INSERT INTO "t" ("data", "text") VALUES
+  (X'6869', 'a`+"\x00"+`b\c''d'),
+  (X'', 'e\f'),
+  (NULL, NULL);`)
+	})
+
+	t.Run("filtered and sorted without header", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendRows([]Row{{"b", 2}, {"a", 1}, {"c", "3"}})
+		tw.FilterBy([]FilterBy{{Number: 1, Operator: NotEqual, Value: "c"}})
+		tw.SortBy([]SortBy{{Number: 1, Mode: Asc}})
+
+		compareOutput(t, tw.RenderSQL("1st table", SQLDialectSQLite), `
+INSERT INTO "_1st_table" ("a", "b") VALUES
+  ('a', 1),
+  ('b', 2);`)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, NewWriter().RenderSQL("t", SQLDialectSQLite))
+
+		tw := NewWriter()
+		tw.AppendHeader(Row{"A"})
+		compareOutput(t, tw.RenderSQL("t", SQLDialectSQLite), "")
+	})
+
+	t.Run("render to", func(t *testing.T) {
+		var sb strings.Builder
+		n, err := tw.RenderSQLTo(&sb, "got", SQLDialectSQLite)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(sb.Len()), n)
+		assert.Equal(t, tw.RenderSQL("got", SQLDialectSQLite)+"\n", sb.String())
+	})
+}
+
+func TestSQLSanitizeIdentifier(t *testing.T) {
+	assert.Equal(t, "first_name", sqlSanitizeIdentifier("First Name", "x"))
+	assert.Equal(t, "salary_usd", sqlSanitizeIdentifier("  Salary ($USD) ", "x"))
+	assert.Equal(t, "_2024", sqlSanitizeIdentifier("2024", "x"))
+	assert.Equal(t, "x", sqlSanitizeIdentifier("#", "x"))
+	assert.Equal(t, "x", sqlSanitizeIdentifier("", "x"))
+}
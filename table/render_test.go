@@ -184,6 +184,44 @@ func TestTable_Render_Align(t *testing.T) {
 	})
 }
 
+func TestTable_Render_AlignDecimal(t *testing.T) {
+	t.Run("dot", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Item", "Price"})
+		tw.AppendRows([]Row{{"Apple", 3}, {"Banana", 12.5}, {"Cherry", 1000.125}, {"Durian", "N/A"}})
+		tw.AppendFooter(Row{"Total", 1015.625})
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Price", Align: text.AlignDecimal}})
+
+		compareOutput(t, tw.Render(), `
++--------+----------+
+| ITEM   | PRICE    |
++--------+----------+
+| Apple  |    3     |
+| Banana |   12.5   |
+| Cherry | 1000.125 |
+| Durian |  N/A     |
++--------+----------+
+| TOTAL  | 1015.625 |
++--------+----------+`)
+	})
+
+	t.Run("comma", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Item", "Price (EUR)"})
+		tw.AppendRows([]Row{{"Apple", "3"}, {"Banana", "1.212,5"}, {"Cherry", "1000,125"}})
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Price (EUR)", Align: text.AlignDecimal, DecimalSeparator: ","}})
+
+		compareOutput(t, tw.Render(), `
++--------+-------------+
+| ITEM   | PRICE (EUR) |
++--------+-------------+
+| Apple  |       3     |
+| Banana |   1.212,5   |
+| Cherry |    1000,125 |
++--------+-------------+`)
+	})
+}
+
 func TestTable_Render_AutoIndex(t *testing.T) {
 	tw := NewWriter()
 	for rowIdx := 0; rowIdx < 10; rowIdx++ {
@@ -1089,6 +1127,70 @@ func TestTable_Render_ColumnConfigs(t *testing.T) {
 	)
 }
 
+func TestTable_Render_ComputedColumns(t *testing.T) {
+	utilization := func(row Row) interface{} {
+		return float64(row[1].(int)) / float64(row[2].(int)) * 100
+	}
+	newTable := func() Writer {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Disk", "Used", "Total"})
+		tw.AppendRows([]Row{{"sda", 30, 100}, {"sdb", 95, 100}, {"sdc", 10, 40}})
+		tw.SetColumnConfigs([]ColumnConfig{{
+			Name:        "Utilization",
+			Compute:     utilization,
+			Transformer: text.NewNumberTransformer("%.1f%%"),
+		}})
+		tw.Style().Color = ColorOptions{}
+		return tw
+	}
+
+	t.Run("virtual column", func(t *testing.T) {
+		tw := newTable()
+		tw.SortBy([]SortBy{{Name: "Utilization", Mode: Dsc}})
+		tw.FilterBy([]FilterBy{{Name: "Utilization", Operator: GreaterThan, Value: 26}})
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+------+------+-------+-------------+\n"+
+			"| DISK | USED | TOTAL | UTILIZATION |\n"+
+			"+------+------+-------+-------------+\n"+
+			"| sdb  |   95 |   100 |       \x1b[92m95.0%\x1b[0m |\n"+
+			"| sda  |   30 |   100 |       \x1b[92m30.0%\x1b[0m |\n"+
+			"+------+------+-------+-------------+")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Utilization", Compute: utilization}})
+
+		compareOutput(t, tw.RenderCSV(), `
+Disk,Used,Total,Utilization
+sda,30,100,30
+sdb,95,100,95
+sdc,10,40,25`)
+	})
+
+	t.Run("existing column", func(t *testing.T) {
+		tw := newTable()
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Total", Compute: func(row Row) interface{} {
+				return row[2].(int) * 2
+			}},
+			{Number: 4, Compute: func(row Row) interface{} {
+				return row[2].(int) - row[1].(int) // uses the computed Total
+			}},
+		})
+
+		compareOutput(t, tw.Render(), `
++------+------+-------+-----+
+| DISK | USED | TOTAL |     |
++------+------+-------+-----+
+| sda  |   30 |   200 | 170 |
+| sdb  |   95 |   200 | 105 |
+| sdc  |   10 |    80 |  70 |
++------+------+-------+-----+`)
+	})
+}
+
 func TestTable_Render_CRLF(t *testing.T) {
 	tw := NewWriter()
 	tw.AppendHeader(testHeader)
@@ -1852,6 +1954,27 @@ func TestTable_Render_TableWithTransformers(t *testing.T) {
 	}
 }
 
+func TestTable_Render_TableWithTransformerWithContext(t *testing.T) {
+	percentage, err := text.NewTemplateTransformer(`{{.Value}} of {{index .Columns "Total"}}{{if eq .RowNumber 2}} (#2){{end}}`)
+	assert.Nil(t, err)
+
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Disk", "Used", "Total"})
+	tw.AppendRows([]Row{{"sda", 30, 100}, {"sdb", 95, 100}})
+	tw.AppendFooter(Row{"", 125, 200})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Used", TransformerWithContext: percentage}})
+
+	compareOutput(t, tw.Render(), `
++------+----------------+-------+
+| DISK |           USED | TOTAL |
++------+----------------+-------+
+| sda  |      30 of 100 |   100 |
+| sdb  | 95 of 100 (#2) |   100 |
++------+----------------+-------+
+|      |            125 |   200 |
++------+----------------+-------+`)
+}
+
 func TestTable_Render_SetWidth_Title(t *testing.T) {
 	tw := NewWriter()
 	tw.AppendHeader(testHeader)
@@ -0,0 +1,121 @@
+package table
+
+import (
+	"io"
+	"strings"
+)
+
+// renderToChunkSize is the amount of output buffered before being written to
+// the io.Writer given to RenderTo (and its variants).
+const renderToChunkSize = 64 * 1024
+
+// renderTarget is the io.Writer given to RenderTo (and its variants) along with
+// the outcome of writing to it.
+type renderTarget struct {
+	err     error
+	w       io.Writer
+	written int64
+}
+
+func (rt *renderTarget) write(str string) {
+	if rt.err == nil && str != "" {
+		n, err := io.WriteString(rt.w, str)
+		rt.written += int64(n)
+		rt.err = err
+	}
+}
+
+// RenderTo renders the Table like Render() does, but writes the output to the
+// given io.Writer in chunks as it is generated instead of building it all in
+// memory first. Returns the number of bytes written along with the first
+// error returned by the io.Writer, which stops the rendering early (ex.: on a
+// broken pipe). The output ends with a newline, as with SetOutputMirror().
+//
+// In strict mode (see SetStrictMode), the error returned by Validate() is
+// returned without rendering anything.
+func (t *Table) RenderTo(w io.Writer) (int64, error) {
+	return t.renderTo(w, t.Render)
+}
+
+// RenderCSVTo renders the Table like RenderCSV() to the given io.Writer. See
+// RenderTo() for details.
+func (t *Table) RenderCSVTo(w io.Writer) (int64, error) {
+	return t.renderTo(w, t.RenderCSV)
+}
+
+// RenderHTMLTo renders the Table like RenderHTML() to the given io.Writer. See
+// RenderTo() for details.
+func (t *Table) RenderHTMLTo(w io.Writer) (int64, error) {
+	return t.renderTo(w, t.RenderHTML)
+}
+
+// RenderHTMLDocumentTo renders the Table like RenderHTMLDocument() to the
+// given io.Writer. See RenderTo() for details.
+func (t *Table) RenderHTMLDocumentTo(w io.Writer, opts HTMLDocumentOptions) (int64, error) {
+	return t.renderTo(w, func() string {
+		return t.RenderHTMLDocument(opts)
+	})
+}
+
+// RenderMarkdownTo renders the Table like RenderMarkdown() to the given
+// io.Writer. See RenderTo() for details.
+func (t *Table) RenderMarkdownTo(w io.Writer) (int64, error) {
+	return t.renderTo(w, t.RenderMarkdown)
+}
+
+// RenderSQLTo renders the Table like RenderSQL() to the given io.Writer. See
+// RenderTo() for details.
+func (t *Table) RenderSQLTo(w io.Writer, tableName string, dialect SQLDialect) (int64, error) {
+	return t.renderTo(w, func() string {
+		return t.RenderSQL(tableName, dialect)
+	})
+}
+
+// RenderTSVTo renders the Table like RenderTSV() to the given io.Writer. See
+// RenderTo() for details.
+func (t *Table) RenderTSVTo(w io.Writer) (int64, error) {
+	return t.renderTo(w, t.RenderTSV)
+}
+
+// RenderVerticalTo renders the Table like RenderVertical() to the given
+// io.Writer. See RenderTo() for details.
+func (t *Table) RenderVerticalTo(w io.Writer) (int64, error) {
+	return t.renderTo(w, t.RenderVertical)
+}
+
+func (t *Table) renderTo(w io.Writer, render func() string) (int64, error) {
+	if t.strictMode {
+		if err := t.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	t.renderTarget = &renderTarget{w: w}
+	defer func() {
+		t.renderTarget = nil
+	}()
+
+	render()
+	return t.renderTarget.written, t.renderTarget.err
+}
+
+// hasRenderedOutput returns true if anything has been rendered so far,
+// including the output already flushed to the io.Writer given to RenderTo.
+func (t *Table) hasRenderedOutput(out *strings.Builder) bool {
+	return out.Len() > 0 || (t.renderTarget != nil && t.renderTarget.written > 0)
+}
+
+// renderToFlush writes the output rendered so far to the io.Writer given to
+// RenderTo once enough of it has been buffered; this is expected to be called
+// only in between rows. Returns false if the rendering should stop because
+// the io.Writer returned an error.
+func (t *Table) renderToFlush(out *strings.Builder) bool {
+	if t.renderTarget == nil {
+		return true
+	}
+	if out.Len() >= renderToChunkSize {
+		t.renderTarget.write(t.finalizeOutput(out.String()))
+		out.Reset()
+	}
+	return t.renderTarget.err == nil
+}
@@ -0,0 +1,110 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type errorWriter struct {
+	err       error
+	numWrites int
+}
+
+func (w *errorWriter) Write(_ []byte) (int, error) {
+	w.numWrites++
+	return 0, w.err
+}
+
+func generateTableForRenderTo(numRows int) *Table {
+	tw := &Table{}
+	tw.SetTitle(testTitle1)
+	tw.AppendHeader(testHeader)
+	for idx := 0; idx < numRows; idx++ {
+		tw.AppendRow(Row{idx, fmt.Sprintf("First Name %d", idx), "Last Name", idx * 100, ""})
+		if idx%100 == 99 {
+			tw.AppendSeparator()
+		}
+	}
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	return tw
+}
+
+func TestTable_RenderTo(t *testing.T) {
+	tw := generateTableForRenderTo(5000)
+	for _, suppressTrailingSpaces := range []bool{false, true} {
+		tw.suppressTrailingSpaces = suppressTrailingSpaces
+
+		for name, renderFuncs := range map[string]struct {
+			render   func() string
+			renderTo func(sb *strings.Builder) (int64, error)
+		}{
+			"default":  {tw.Render, func(sb *strings.Builder) (int64, error) { return tw.RenderTo(sb) }},
+			"csv":      {tw.RenderCSV, func(sb *strings.Builder) (int64, error) { return tw.RenderCSVTo(sb) }},
+			"html":     {tw.RenderHTML, func(sb *strings.Builder) (int64, error) { return tw.RenderHTMLTo(sb) }},
+			"markdown": {tw.RenderMarkdown, func(sb *strings.Builder) (int64, error) { return tw.RenderMarkdownTo(sb) }},
+			"tsv":      {tw.RenderTSV, func(sb *strings.Builder) (int64, error) { return tw.RenderTSVTo(sb) }},
+			"vertical": {tw.RenderVertical, func(sb *strings.Builder) (int64, error) { return tw.RenderVerticalTo(sb) }},
+		} {
+			t.Run(fmt.Sprintf("%s/suppressTrailingSpaces=%v", name, suppressTrailingSpaces), func(t *testing.T) {
+				expected := renderFuncs.render() + "\n"
+				assert.Greater(t, len(expected), renderToChunkSize*2)
+
+				var out strings.Builder
+				n, err := renderFuncs.renderTo(&out)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(len(expected)), n)
+				assert.Equal(t, expected, out.String())
+			})
+		}
+	}
+}
+
+func TestTable_RenderTo_EmptyTable(t *testing.T) {
+	tw := &Table{}
+
+	var out strings.Builder
+	n, err := tw.RenderTo(&out)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Empty(t, out.String())
+}
+
+func TestTable_RenderTo_Error(t *testing.T) {
+	tw := generateTableForRenderTo(5000)
+	errBrokenPipe := errors.New("broken pipe")
+
+	for name, renderTo := range map[string]func(w *errorWriter) (int64, error){
+		"default":  func(w *errorWriter) (int64, error) { return tw.RenderTo(w) },
+		"csv":      func(w *errorWriter) (int64, error) { return tw.RenderCSVTo(w) },
+		"html":     func(w *errorWriter) (int64, error) { return tw.RenderHTMLTo(w) },
+		"markdown": func(w *errorWriter) (int64, error) { return tw.RenderMarkdownTo(w) },
+		"tsv":      func(w *errorWriter) (int64, error) { return tw.RenderTSVTo(w) },
+		"vertical": func(w *errorWriter) (int64, error) { return tw.RenderVerticalTo(w) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := &errorWriter{err: errBrokenPipe}
+			n, err := renderTo(w)
+			assert.Equal(t, errBrokenPipe, err)
+			assert.Zero(t, n)
+			assert.Equal(t, 1, w.numWrites)
+		})
+	}
+}
+
+func TestTable_RenderTo_OutputMirror(t *testing.T) {
+	tw := generateTableForRenderTo(10)
+	mirror := &myMockOutputMirror{}
+	tw.SetOutputMirror(mirror)
+
+	var out strings.Builder
+	_, err := tw.RenderTo(&out)
+	assert.NoError(t, err)
+	assert.Empty(t, mirror.mirroredOutput)
+	assert.Equal(t, tw.Render()+"\n", out.String())
+	assert.Equal(t, out.String(), mirror.mirroredOutput)
+}
@@ -34,7 +34,7 @@ func (t *Table) RenderTSV() string {
 }
 
 func (t *Table) tsvRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
-	if out.Len() > 0 {
+	if t.hasRenderedOutput(out) {
 		out.WriteRune('\n')
 	}
 
@@ -65,6 +65,9 @@ func (t *Table) tsvRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 
 func (t *Table) tsvRenderRows(out *strings.Builder, rows []rowStr, hint renderHint) {
 	for idx, row := range rows {
+		if !t.renderToFlush(out) {
+			return
+		}
 		hint.rowNumber = idx + 1
 		t.tsvRenderRow(out, row, hint)
 	}
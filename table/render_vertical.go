@@ -0,0 +1,172 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// RenderVertical renders the Table with each row printed as a "record" made up
+// of one "header | value" line per column. This is useful when the rows have
+// too many columns to fit in the terminal. Example:
+//
+//	-[ RECORD 1 ]---------------------------
+//	#          | 1
+//	FIRST NAME | Arya
+//	LAST NAME  | Stark
+//	SALARY     | 3000
+//	           |
+//	-[ RECORD 2 ]---------------------------
+//	#          | 20
+//	FIRST NAME | Jon
+//	LAST NAME  | Snow
+//	SALARY     | 2000
+//	           | You know nothing, Jon Snow!
+//	-[ FOOTER ]-----------------------------
+//	LAST NAME  | TOTAL
+//	SALARY     | 10000
+//
+// The characters used come from Style().Box, and the column configs
+// (Transformers, Colors, WidthMax, etc.) are honored like in Render(). The
+// header labels come from the last header row; when there is no header, the
+// auto-index column IDs (A, B, C, ...) are used. Empty footer cells are
+// skipped, and the record number takes the place of the auto-index column.
+func (t *Table) RenderVertical() string {
+	t.initForRender(renderModeDefault)
+
+	var out strings.Builder
+	if t.numColumns > 0 {
+		if t.title != "" {
+			titleColors := t.style.Title.Colors
+			out.WriteString(titleColors.Sprint(t.style.Title.Format.Apply(t.title)))
+		}
+
+		keys, keyWidth := t.verticalKeys()
+		valueWidth := 0
+		for _, row := range append(t.rows, t.rowsFooter...) {
+			for colIdx := range keys {
+				if colIdx < len(row) {
+					value := t.wrapCell(colIdx, t.style.Format.Row.Apply(row[colIdx]))
+					if valueLen := text.LongestLineLen(value); valueLen > valueWidth {
+						valueWidth = valueLen
+					}
+				}
+			}
+		}
+
+		for rowIdx, row := range t.rows {
+			if !t.renderToFlush(&out) {
+				break
+			}
+			hint := renderHint{rowNumber: rowIdx + 1}
+			t.verticalRenderSeparator(&out, fmt.Sprintf("RECORD %d", rowIdx+1), keyWidth, valueWidth, hint)
+			t.verticalRenderRow(&out, row, keys, keyWidth, hint)
+		}
+		for rowIdx, row := range t.rowsFooter {
+			if !t.renderToFlush(&out) {
+				break
+			}
+			hint := renderHint{isFooterRow: true, rowNumber: rowIdx + 1}
+			t.verticalRenderSeparator(&out, "FOOTER", keyWidth, valueWidth, hint)
+			t.verticalRenderRow(&out, row, keys, keyWidth, hint)
+		}
+
+		if t.caption != "" {
+			out.WriteRune('\n')
+			out.WriteString(t.caption)
+		}
+	}
+	return t.render(&out)
+}
+
+// verticalKeys returns the labels to be used for each column in the vertical
+// mode, along with the length of the longest one.
+func (t *Table) verticalKeys() (rowStr, int) {
+	keys := t.getAutoIndexColumnIDs()
+	if len(t.rowsHeader) > len(t.columnGroupRows) {
+		header := t.rowsHeader[len(t.rowsHeader)-1]
+		for colIdx := range keys {
+			if colIdx < len(header) {
+				keys[colIdx] = t.style.Format.Header.Apply(header[colIdx])
+			} else {
+				keys[colIdx] = ""
+			}
+		}
+	}
+
+	keyWidth := 0
+	for _, key := range keys {
+		if keyLen := text.LongestLineLen(key); keyLen > keyWidth {
+			keyWidth = keyLen
+		}
+	}
+	return keys, keyWidth
+}
+
+func (t *Table) verticalRenderRow(out *strings.Builder, row rowStr, keys rowStr, keyWidth int, hint renderHint) {
+	separator := t.style.Box.PaddingRight + t.style.Box.MiddleVertical + t.style.Box.PaddingLeft
+	separatorColors := t.getSeparatorColors(hint)
+	hintKey := renderHint{isHeaderRow: true}
+
+	for colIdx, key := range keys {
+		var value string
+		if colIdx < len(row) {
+			value = t.wrapCell(colIdx, t.getFormat(hint).Apply(row[colIdx]))
+		}
+		if hint.isFooterRow && value == "" {
+			continue
+		}
+
+		keyLines := strings.Split(key, "\n")
+		valueLines := strings.Split(value, "\n")
+		numLines := len(keyLines)
+		if len(valueLines) > numLines {
+			numLines = len(valueLines)
+		}
+		for lineIdx := 0; lineIdx < numLines; lineIdx++ {
+			var keyLine, valueLine string
+			if lineIdx < len(keyLines) {
+				keyLine = keyLines[lineIdx]
+			}
+			if lineIdx < len(valueLines) {
+				valueLine = valueLines[lineIdx]
+			}
+
+			out.WriteRune('\n')
+			t.renderColumnColorized(out, colIdx, text.AlignLeft.Apply(keyLine, keyWidth), hintKey)
+			out.WriteString(separatorColors.Sprint(separator))
+			t.renderColumnColorized(out, colIdx, valueLine, hint)
+		}
+	}
+}
+
+// verticalRenderSeparator renders the line separating the records, with the
+// label embedded in it, and a cross at the column separator if it fits.
+// Example: "-[ RECORD 1 ]-+------"
+func (t *Table) verticalRenderSeparator(out *strings.Builder, label string, keyWidth int, valueWidth int, hint renderHint) {
+	horizontal := t.style.Box.middleHorizontal(separatorTypeRowMiddle)
+	prefix := horizontal + "[ " + label + " ]"
+	prefixLen := text.StringWidthWithoutEscSequences(prefix)
+	keyLen := keyWidth + text.StringWidthWithoutEscSequences(t.style.Box.PaddingRight)
+	valueLen := valueWidth + text.StringWidthWithoutEscSequences(t.style.Box.PaddingLeft)
+
+	var separator strings.Builder
+	separator.WriteString(prefix)
+	if prefixLen < keyLen {
+		separator.WriteString(text.RepeatAndTrim(horizontal, keyLen-prefixLen))
+		separator.WriteString(t.style.Box.MiddleSeparator)
+		separator.WriteString(text.RepeatAndTrim(horizontal, valueLen))
+	} else {
+		lineLen := keyLen + text.StringWidthWithoutEscSequences(t.style.Box.MiddleSeparator) + valueLen
+		if lineLen <= prefixLen {
+			lineLen = prefixLen + 1
+		}
+		separator.WriteString(text.RepeatAndTrim(horizontal, lineLen-prefixLen))
+	}
+
+	if t.hasRenderedOutput(out) {
+		out.WriteRune('\n')
+	}
+	out.WriteString(t.getSeparatorColors(hint).Sprint(separator.String()))
+}
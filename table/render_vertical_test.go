@@ -0,0 +1,168 @@
+package table
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTable_RenderVertical(t *testing.T) {
+	tests := []struct {
+		name   string
+		tw     func() Writer
+		output string
+	}{
+		{
+			tw: func() Writer {
+				tw := NewWriter()
+				tw.AppendHeader(testHeader)
+				tw.AppendRows(testRows)
+				tw.AppendFooter(testFooter)
+				tw.SetCaption(testCaption)
+				tw.SetTitle(testTitle1)
+				tw.SuppressTrailingSpaces()
+				return tw
+			},
+			output: `
+Game of Thrones
+-[ RECORD 1 ]---------------------------
+#          | 1
+FIRST NAME | Arya
+LAST NAME  | Stark
+SALARY     | 3000
+           |
+-[ RECORD 2 ]---------------------------
+#          | 20
+FIRST NAME | Jon
+LAST NAME  | Snow
+SALARY     | 2000
+           | You know nothing, Jon Snow!
+-[ RECORD 3 ]---------------------------
+#          | 300
+FIRST NAME | Tyrion
+LAST NAME  | Lannister
+SALARY     | 5000
+           |
+-[ FOOTER ]-----------------------------
+LAST NAME  | TOTAL
+SALARY     | 10000
+A Song of Ice and Fire`,
+		},
+		{
+			name: "Column configs",
+			tw: func() Writer {
+				tw := NewWriter()
+				tw.AppendHeader(testHeader)
+				tw.AppendRows(testRows)
+				tw.SetColumnConfigs([]ColumnConfig{
+					{Name: "Salary", Transformer: func(val interface{}) string {
+						return fmt.Sprintf("$%v", val)
+					}},
+					{Number: 5, WidthMax: 10},
+				})
+				tw.SetStyle(StyleLight)
+				tw.SuppressTrailingSpaces()
+				return tw
+			},
+			output: `
+─[ RECORD 1 ]──────────
+#          │ 1
+FIRST NAME │ Arya
+LAST NAME  │ Stark
+SALARY     │ $3000
+           │
+─[ RECORD 2 ]──────────
+#          │ 20
+FIRST NAME │ Jon
+LAST NAME  │ Snow
+SALARY     │ $2000
+           │ You know n
+           │ othing, Jo
+           │ n Snow!
+─[ RECORD 3 ]──────────
+#          │ 300
+FIRST NAME │ Tyrion
+LAST NAME  │ Lannister
+SALARY     │ $5000
+           │`,
+		},
+		{
+			name: "Cross at the column separator",
+			tw: func() Writer {
+				tw := NewWriter()
+				tw.AppendHeader(Row{"Character Name", "House"})
+				tw.AppendRow(Row{"Arya", "Stark"})
+				tw.AppendRow(Row{"Tyrion", "Lannister"})
+				tw.SetStyle(StyleLight)
+				return tw
+			},
+			output: `
+─[ RECORD 1 ]──┼──────────
+CHARACTER NAME │ Arya
+HOUSE          │ Stark
+─[ RECORD 2 ]──┼──────────
+CHARACTER NAME │ Tyrion
+HOUSE          │ Lannister`,
+		},
+		{
+			name: "No header",
+			tw: func() Writer {
+				tw := NewWriter()
+				tw.AppendRows(testRows)
+				tw.SuppressTrailingSpaces()
+				return tw
+			},
+			output: `
+-[ RECORD 1 ]------------------
+A | 1
+B | Arya
+C | Stark
+D | 3000
+E |
+-[ RECORD 2 ]------------------
+A | 20
+B | Jon
+C | Snow
+D | 2000
+E | You know nothing, Jon Snow!
+-[ RECORD 3 ]------------------
+A | 300
+B | Tyrion
+C | Lannister
+D | 5000
+E |`,
+		},
+		{
+			name: "Empty",
+			tw: func() Writer {
+				tw := NewWriter()
+				return tw
+			},
+			output: ``,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := tt.tw().RenderVertical()
+			compareOutput(t, output, tt.output)
+		})
+	}
+}
+
+func TestTable_RenderVertical_Colored(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "House"})
+	tw.AppendRow(Row{"Arya", "Stark"})
+	tw.AppendRow(Row{"Tyrion", "Lannister"})
+	tw.SetStyle(StyleColoredBright)
+
+	expectedOut := "" +
+		"\x1b[107;30m-[ RECORD 1 ]----\x1b[0m\n" +
+		"\x1b[106;30mNAME \x1b[0m\x1b[107;30m | \x1b[0m\x1b[107;30mArya\x1b[0m\n" +
+		"\x1b[106;30mHOUSE\x1b[0m\x1b[107;30m | \x1b[0m\x1b[107;30mStark\x1b[0m\n" +
+		"\x1b[47;30m-[ RECORD 2 ]----\x1b[0m\n" +
+		"\x1b[106;30mNAME \x1b[0m\x1b[47;30m | \x1b[0m\x1b[47;30mTyrion\x1b[0m\n" +
+		"\x1b[106;30mHOUSE\x1b[0m\x1b[47;30m | \x1b[0m\x1b[47;30mLannister\x1b[0m"
+
+	compareOutputColored(t, tw.RenderVertical(), expectedOut)
+}
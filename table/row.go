@@ -9,6 +9,25 @@ import (
 // Row defines a single row in the Table.
 type Row []interface{}
 
+// copyRow returns a shallow copy of the row.
+func copyRow(row Row) Row {
+	rowCopy := make(Row, len(row))
+	copy(rowCopy, row)
+	return rowCopy
+}
+
+// copyRows returns a copy of the rows made using copyRow.
+func copyRows(rows []Row) []Row {
+	if rows == nil {
+		return nil
+	}
+	rowsCopy := make([]Row, len(rows))
+	for idx, row := range rows {
+		rowsCopy[idx] = copyRow(row)
+	}
+	return rowsCopy
+}
+
 func (r Row) findColumnNumber(colName string) int {
 	for colIdx, col := range r {
 		if fmt.Sprint(col) == colName {
@@ -18,6 +37,25 @@ func (r Row) findColumnNumber(colName string) int {
 	return 0
 }
 
+// cellRenderer is implemented by cell values that have to be rendered
+// differently based on the render mode (ex.: cells generated by Diff).
+type cellRenderer interface {
+	// cellValue returns the underlying value to use while analyzing the
+	// column (ex.: to determine if the column is numeric)
+	cellValue() interface{}
+	// renderCell returns the string form of the cell for the current render
+	// mode; in HTML mode, the returned string is expected to be HTML-safe
+	renderCell(t *Table, colIdx int, hint renderHint) string
+}
+
+// cellValueOf returns the underlying value of the cell.
+func cellValueOf(col interface{}) interface{} {
+	if cr, ok := col.(cellRenderer); ok {
+		return cr.cellValue()
+	}
+	return col
+}
+
 // RowAttributes contains properties about the Row during the render.
 type RowAttributes struct {
 	Number       int // Row Number (1-indexed) as appended
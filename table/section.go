@@ -0,0 +1,126 @@
+package table
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// rowSection is a titled section of rows appended with AppendSection.
+type rowSection struct {
+	// colors defines the colors to be used on the title
+	colors text.Colors
+	// notes contains the notes appended right after the section
+	notes []string
+	// rowIdx is the index in rowsRaw of the first row in the section
+	rowIdx int
+	// title is the text to render in the section row
+	title string
+}
+
+func copySections(sections []rowSection) []rowSection {
+	if sections == nil {
+		return nil
+	}
+	sectionsCopy := make([]rowSection, len(sections))
+	for idx, section := range sections {
+		sectionsCopy[idx] = section
+		sectionsCopy[idx].colors = copyColors(section.colors)
+		sectionsCopy[idx].notes = append([]string(nil), section.notes...)
+	}
+	return sectionsCopy
+}
+
+// getSectionIndex returns the index of the section the given row (index in
+// rowsRaw) belongs to, or -1 if it precedes all the sections.
+func (t *Table) getSectionIndex(rowIdxRaw int) int {
+	return sort.Search(len(t.sections), func(idx int) bool {
+		return t.sections[idx].rowIdx > rowIdxRaw
+	}) - 1
+}
+
+// getRowNotes returns the notes to render after the given row, or above all
+// the rows if the index is -1.
+func (t *Table) getRowNotes(rowIdx int, hint renderHint) []string {
+	if !hint.isRegularRow() {
+		return nil
+	}
+	return t.rowsNotes[rowIdx]
+}
+
+// getRowSectionsWidth returns the length of the longest section row being
+// rendered for its title to fit in full (with the lead-in, the spaces around
+// the title and the borders), if any.
+func (t *Table) getRowSectionsWidth() int {
+	width := 0
+	for _, section := range t.rowsSections {
+		title := strings.ReplaceAll(section.title, "\n", " ")
+		if title == "" {
+			continue
+		}
+		horizontal := t.style.Box.middleHorizontal(separatorTypeRowMiddle)
+		if horizontal == "" {
+			horizontal = " "
+		}
+		widthSection := text.StringWidthWithoutEscSequences(text.RepeatAndTrim(horizontal, 2)) + 2 +
+			text.StringWidthWithoutEscSequences(title)
+		if t.style.Options.DrawBorder {
+			widthSection += text.StringWidthWithoutEscSequences(t.style.Box.LeftSeparator + t.style.Box.RightSeparator)
+		}
+		if widthSection > width {
+			width = widthSection
+		}
+	}
+	return width
+}
+
+// getRowSection returns the section to render above the given row, if any.
+func (t *Table) getRowSection(rowIdx int, hint renderHint) *rowSection {
+	if !hint.isRegularRow() {
+		return nil
+	}
+	return t.rowsSections[rowIdx]
+}
+
+// initForRenderSections maps the sections and notes to the rows being rendered
+// (after filtering and sorting). Each section is rendered above the first row
+// in it, and each note below the row it was appended after.
+func (t *Table) initForRenderSections() {
+	if len(t.sections) == 0 && len(t.notes) == 0 {
+		return
+	}
+
+	t.rowsNotes = make(map[int][]string)
+	t.rowsSections = make(map[int]*rowSection)
+	if notes := t.notes[-1]; len(notes) > 0 && len(t.rows) > 0 {
+		t.rowsNotes[-1] = notes
+	}
+	renderedSections := make(map[int]bool)
+	for rowIdx := range t.rows {
+		rowIdxRaw := t.getRowIndexRaw(rowIdx)
+		if t.sortedRowIndices != nil {
+			rowIdxRaw = t.getRowIndexRaw(t.sortedRowIndices[rowIdx])
+		}
+
+		if sectionIdx := t.getSectionIndex(rowIdxRaw); sectionIdx >= 0 && !renderedSections[sectionIdx] {
+			t.rowsSections[rowIdx] = &t.sections[sectionIdx]
+			renderedSections[sectionIdx] = true
+		}
+		if notes := t.notes[rowIdxRaw]; len(notes) > 0 {
+			t.rowsNotes[rowIdx] = notes
+		}
+	}
+}
+
+// sortWithinSections re-arranges the sorted rows (indices in rowsRawFiltered)
+// such that they stay within their sections.
+func (t *Table) sortWithinSections(sortedRowIndices []int) {
+	if len(t.sections) == 0 {
+		return
+	}
+	sort.SliceStable(sortedRowIndices, func(i, j int) bool {
+		return t.getSectionIndex(t.getRowIndexRaw(sortedRowIndices[i])) <
+			t.getSectionIndex(t.getRowIndexRaw(sortedRowIndices[j]))
+	})
+}
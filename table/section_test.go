@@ -0,0 +1,224 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTableWithSections() Writer {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Host", "Status"})
+	tw.AppendSection("Production")
+	tw.AppendRow(Row{"web-2", "OK"})
+	tw.AppendRow(Row{"db-1", "FAIL"})
+	tw.AppendNote("error: connection refused")
+	tw.AppendRow(Row{"web-1", "OK"})
+	tw.AppendSection("Staging")
+	tw.AppendNote("deployed 5 minutes ago")
+	tw.AppendRow(Row{"web-3", "OK"})
+	tw.AppendRow(Row{"cache-1", "OK"})
+	tw.SetStyle(StyleLight)
+	return tw
+}
+
+func TestTable_AppendSection(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		tw := generateTableWithSections()
+
+		compareOutput(t, tw.Render(), `
+┌─────────┬────────┐
+│ HOST    │ STATUS │
+├── Production ────┤
+│ web-2   │ OK     │
+│ db-1    │ FAIL   │
+│ error:           │
+│ connection       │
+│ refused          │
+│ web-1   │ OK     │
+├── Staging ───────┤
+│ deployed 5       │
+│ minutes ago      │
+│ web-3   │ OK     │
+│ cache-1 │ OK     │
+└─────────┴────────┘`)
+		assert.Equal(t, 5, tw.Length())
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+		tw := generateTableWithSections()
+		tw.SortBy([]SortBy{{Name: "Host"}})
+		tw.Style().Options.SeparateRows = true
+
+		compareOutput(t, tw.Render(), `
+┌─────────┬────────┐
+│ HOST    │ STATUS │
+├── Production ────┤
+│ db-1    │ FAIL   │
+│ error:           │
+│ connection       │
+│ refused          │
+├─────────┼────────┤
+│ web-1   │ OK     │
+├─────────┼────────┤
+│ web-2   │ OK     │
+├── Staging ───────┤
+│ deployed 5       │
+│ minutes ago      │
+│ cache-1 │ OK     │
+├─────────┼────────┤
+│ web-3   │ OK     │
+└─────────┴────────┘`)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		tw := generateTableWithSections()
+		tw.FilterBy([]FilterBy{{Name: "Host", Operator: Contains, Value: "web"}})
+		tw.SetAutoIndex(true)
+
+		compareOutput(t, tw.Render(), `
+┌───┬───────┬────────┐
+│   │ HOST  │ STATUS │
+├── Production ──────┤
+│ 1 │ web-2 │ OK     │
+│ 2 │ web-1 │ OK     │
+├── Staging ─────────┤
+│ deployed 5 minutes │
+│ ago                │
+│ 3 │ web-3 │ OK     │
+└───┴───────┴────────┘`)
+	})
+
+	t.Run("colors, long titles and notes before any row", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendNote("all times are in UTC")
+		tw.AppendRow(Row{"Arya", "10:00"})
+		tw.AppendSection("Night's Watch", text.FgRed)
+		tw.AppendRow(Row{"Jon", "11:00"})
+		tw.AppendSection("without rows")
+
+		compareOutputColored(t, tw.Render(), ""+
+			"+--------+--------+\n"+
+			"| all times are   |\n"+
+			"| in UTC          |\n"+
+			"| Arya   | 10:00  |\n"+
+			"+-- \x1b[31mNight's Watch\x1b[0m +\n"+
+			"| Jon    | 11:00  |\n"+
+			"+--------+--------+")
+	})
+
+	t.Run("narrow table", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendSection("Production")
+		tw.AppendRow(Row{1, "x"})
+		tw.AppendSection("Dev\nQA")
+		tw.AppendRow(Row{2, "y"})
+
+		// the columns are widened for the longest title to fit in full
+		compareOutput(t, tw.Render(), `
++-------+------+
++-- Production +
+|     1 | x    |
++-- Dev QA ----+
+|     2 | y    |
++-------+------+`)
+		tw.SetStyle(StyleLight)
+		compareOutput(t, tw.Render(), `
+┌───────┬──────┐
+├── Production ┤
+│     1 │ x    │
+├── Dev QA ────┤
+│     2 │ y    │
+└───────┴──────┘`)
+	})
+
+	t.Run("insert, delete and clone", func(t *testing.T) {
+		tw := generateTableWithSections()
+		assert.True(t, tw.InsertRow(3, Row{"web-0", "OK"}))
+		assert.True(t, tw.DeleteRow(1))
+		clone := tw.(*Table).Clone()
+		tw.ResetRows()
+
+		compareOutput(t, clone.Render(), `
+┌─────────┬────────┐
+│ HOST    │ STATUS │
+├── Production ────┤
+│ web-2   │ OK     │
+│ web-1   │ OK     │
+├── Staging ───────┤
+│ deployed 5       │
+│ minutes ago      │
+│ web-0   │ OK     │
+│ web-3   │ OK     │
+│ cache-1 │ OK     │
+└─────────┴────────┘`)
+		compareOutput(t, tw.Render(), `
+┌──────┬────────┐
+│ HOST │ STATUS │
+├──────┼────────┤
+└──────┴────────┘`)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"Host", "Status"})
+		tw.AppendSection("Production", text.FgGreen)
+		tw.AppendRow(Row{"db-1", "FAIL"})
+		tw.AppendNote("error: <connection refused>")
+		tw.SetAutoIndex(true)
+
+		compareOutput(t, tw.RenderHTML(), `
+<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>&nbsp;</th>
+    <th>Host</th>
+    <th>Status</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <th align="left" class="fg-green" colspan=3>Production</th>
+  </tr>
+  <tr>
+    <td align="right">1</td>
+    <td>db-1</td>
+    <td>FAIL</td>
+  </tr>
+  <tr>
+    <td colspan=3>error: &lt;connection refused&gt;</td>
+  </tr>
+  </tbody>
+</table>`)
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		tw := generateTableWithSections()
+
+		compareOutput(t, tw.RenderMarkdown(), `
+| Host | Status |
+| --- | --- |
+| **Production** |  |
+| web-2 | OK |
+| db-1 | FAIL |
+| _error: connection refused_ |  |
+| web-1 | OK |
+| **Staging** |  |
+| _deployed 5 minutes ago_ |  |
+| web-3 | OK |
+| cache-1 | OK |`)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		tw := generateTableWithSections()
+
+		compareOutput(t, tw.RenderCSV(), `
+Host,Status
+web-2,OK
+db-1,FAIL
+web-1,OK
+web-3,OK
+cache-1,OK`)
+	})
+}
@@ -12,7 +12,21 @@ type Style struct {
 	Markdown MarkdownOptions // rendering options for Markdown mode
 	Options  Options         // misc. options for the table
 	Size     SizeOptions     // size (width) options for the table
+	SQL      SQLOptions      // rendering options for SQL mode
 	Title    TitleOptions    // formation options for the title text
+	Tree     TreeOptions     // rendering options for the child rows
+}
+
+// clone returns a copy of the Style that doesn't share the Colors and the
+// BoxStyleHorizontal with the original.
+func (s Style) clone() *Style {
+	if s.Box.Horizontal != nil {
+		horizontal := *s.Box.Horizontal
+		s.Box.Horizontal = &horizontal
+	}
+	s.Color = s.Color.clone()
+	s.Title.Colors = copyColors(s.Title.Colors)
+	return &s
 }
 
 var (
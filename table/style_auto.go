@@ -0,0 +1,70 @@
+package table
+
+import (
+	"unicode/utf8"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// AutoStyle returns a Style suitable for the terminal attached to os.Stdout,
+// as detected by text.DetectCapabilities. StyleLight is used on terminals
+// that support Unicode, and StyleDefault on the rest.
+func AutoStyle() Style {
+	caps := text.DetectCapabilities()
+	if caps.Unicode {
+		return AdaptStyle(StyleLight, caps)
+	}
+	return AdaptStyle(StyleDefault, caps)
+}
+
+// AdaptStyle returns the Style adapted to render well on a terminal with the
+// given Capabilities:
+//   - the box-drawing characters are replaced with the ones in
+//     StyleBoxDefault if the terminal does not support Unicode
+//   - the colors are degraded to the closest ones supported by the terminal,
+//     or removed altogether if the terminal does not support colors
+//
+// Colors set outside the Style (like in ColumnConfig) are left as is.
+func AdaptStyle(style Style, caps text.Capabilities) Style {
+	if !caps.Unicode && !style.Box.isASCII() {
+		style.Box = StyleBoxDefault
+	}
+
+	depth := caps.ColorDepth
+	style.Color = ColorOptions{
+		Border:       style.Color.Border.Degrade(depth),
+		Footer:       style.Color.Footer.Degrade(depth),
+		Header:       style.Color.Header.Degrade(depth),
+		IndexColumn:  style.Color.IndexColumn.Degrade(depth),
+		Row:          style.Color.Row.Degrade(depth),
+		RowAlternate: style.Color.RowAlternate.Degrade(depth),
+		Separator:    style.Color.Separator.Degrade(depth),
+	}
+	style.Title.Colors = style.Title.Colors.Degrade(depth)
+	return style
+}
+
+// isASCII returns true if all the characters in the BoxStyle are ASCII.
+func (bs BoxStyle) isASCII() bool {
+	strs := []string{
+		bs.BottomLeft, bs.BottomRight, bs.BottomSeparator, bs.EmptySeparator,
+		bs.Left, bs.LeftSeparator, bs.MiddleHorizontal, bs.MiddleSeparator,
+		bs.MiddleVertical, bs.PaddingLeft, bs.PaddingRight, bs.PageSeparator,
+		bs.Right, bs.RightSeparator, bs.TopLeft, bs.TopRight, bs.TopSeparator,
+		bs.UnfinishedRow,
+	}
+	if h := bs.Horizontal; h != nil {
+		strs = append(strs,
+			h.TitleTop, h.TitleBottom, h.HeaderTop, h.HeaderMiddle, h.HeaderBottom,
+			h.RowTop, h.RowMiddle, h.RowBottom, h.FooterTop, h.FooterMiddle, h.FooterBottom,
+		)
+	}
+	for _, str := range strs {
+		for idx := 0; idx < len(str); idx++ {
+			if str[idx] >= utf8.RuneSelf {
+				return false
+			}
+		}
+	}
+	return true
+}
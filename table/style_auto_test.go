@@ -0,0 +1,56 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptStyle(t *testing.T) {
+	style := StyleRounded
+	style.Color.Header = text.Colors{text.Bold, text.Fg256Color(196)}
+	style.Title.Colors = text.Colors{text.Bg256Color(21)}
+
+	t.Run("unicode and 256 colors", func(t *testing.T) {
+		caps := text.Capabilities{ColorDepth: text.ColorDepth256, IsTTY: true, Unicode: true}
+		assert.Equal(t, style, AdaptStyle(style, caps))
+	})
+
+	t.Run("ascii and 16 colors", func(t *testing.T) {
+		caps := text.Capabilities{ColorDepth: text.ColorDepth16, IsTTY: true}
+		styleAdapted := AdaptStyle(style, caps)
+		assert.Equal(t, StyleBoxDefault, styleAdapted.Box)
+		assert.Equal(t, text.Colors{text.Bold, text.FgHiRed}, styleAdapted.Color.Header)
+		assert.Equal(t, text.Colors{text.BgHiBlue}, styleAdapted.Title.Colors)
+		assert.Equal(t, text.Colors{text.Bold, text.Fg256Color(196)}, style.Color.Header)
+	})
+
+	t.Run("ascii box is retained", func(t *testing.T) {
+		styleASCII := StyleDefault
+		styleASCII.Box.MiddleHorizontal = "="
+		assert.Equal(t, styleASCII, AdaptStyle(styleASCII, text.Capabilities{}))
+
+		styleASCII.Box.Horizontal = NewBoxStyleHorizontal("─")
+		assert.Equal(t, StyleBoxDefault, AdaptStyle(styleASCII, text.Capabilities{}).Box)
+	})
+
+	t.Run("no colors", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"#", "Name"})
+		tw.AppendRow(Row{1, "Arya"})
+		tw.SetStyle(AdaptStyle(StyleColoredBright, text.Capabilities{}))
+		compareOutput(t, tw.Render(), `
+ #  NAME 
+ 1  Arya `)
+	})
+}
+
+func TestAutoStyle(t *testing.T) {
+	style := AutoStyle()
+	if text.DetectCapabilities().Unicode {
+		assert.Equal(t, StyleLight.Name, style.Name)
+	} else {
+		assert.Equal(t, StyleDefault.Name, style.Name)
+	}
+}
@@ -10,9 +10,31 @@ type ColorOptions struct {
 	IndexColumn  text.Colors // index-column colors (row #, etc.)
 	Row          text.Colors // regular row(s) colors
 	RowAlternate text.Colors // regular row(s) colors for the even-numbered rows
+	RowsOmitted  text.Colors // row in place of the ones left out by Limit (if nil, uses Row)
 	Separator    text.Colors // separators (if nil, uses one of the above)
 }
 
+// clone returns a copy of the ColorOptions that doesn't share the Colors with
+// the original.
+func (c ColorOptions) clone() ColorOptions {
+	c.Border = copyColors(c.Border)
+	c.Footer = copyColors(c.Footer)
+	c.Header = copyColors(c.Header)
+	c.IndexColumn = copyColors(c.IndexColumn)
+	c.Row = copyColors(c.Row)
+	c.RowAlternate = copyColors(c.RowAlternate)
+	c.RowsOmitted = copyColors(c.RowsOmitted)
+	c.Separator = copyColors(c.Separator)
+	return c
+}
+
+func copyColors(colors text.Colors) text.Colors {
+	if colors == nil {
+		return nil
+	}
+	return append(text.Colors{}, colors...)
+}
+
 var (
 	// ColorOptionsDefault defines sensible ANSI color options - basically NONE.
 	ColorOptionsDefault = ColorOptions{}
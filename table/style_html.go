@@ -6,6 +6,7 @@ type HTMLOptions struct {
 	CSSClass             string // CSS class to set on the overall <table> tag
 	EmptyColumn          string // string to replace "" columns with (entire content being "")
 	EscapeText           bool   // escape text into HTML-safe content?
+	InlineStyles         bool   // render the colors, alignment and borders using "style" attributes instead of CSS classes (for e-mails)?
 	Newline              string // string to replace "\n" characters with
 }
 
@@ -0,0 +1,115 @@
+package table
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStyle_MarshalJSON(t *testing.T) {
+	for _, name := range StyleNames() {
+		style, _ := StyleByName(name)
+
+		b, err := json.Marshal(style)
+		assert.Nil(t, err, name)
+		var style2 Style
+		assert.Nil(t, json.Unmarshal(b, &style2), name)
+		assert.Equal(t, style, style2, name)
+	}
+
+	b, err := json.Marshal(StyleColoredBright.Color)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"Border":null,`+
+		`"Footer":["bg-cyan","fg-black"],`+
+		`"Header":["bg-hi-cyan","fg-black"],`+
+		`"IndexColumn":["bg-hi-cyan","fg-black"],`+
+		`"Row":["bg-hi-white","fg-black"],`+
+		`"RowAlternate":["bg-white","fg-black"],`+
+		`"RowsOmitted":null,`+
+		`"Separator":null}`, string(b))
+}
+
+func TestStyle_UnmarshalJSON(t *testing.T) {
+	var style Style
+	err := json.Unmarshal([]byte(`{
+		"Name": "StyleRounded",
+		"Color": {"Header": ["fg-hi-cyan", "bold"], "Row": ["bg-256:236"]},
+		"Format": {"Header": "title", "RowAlign": "center"}
+	}`), &style)
+	assert.Nil(t, err)
+
+	expected := StyleRounded
+	expected.Color.Header = text.Colors{text.FgHiCyan, text.Bold}
+	expected.Color.Row = text.Colors{text.Bg256Color(236)}
+	expected.Format.Header = text.FormatTitle
+	expected.Format.RowAlign = text.AlignCenter
+	assert.Equal(t, expected, style)
+
+	t.Run("does not modify the registered style", func(t *testing.T) {
+		var style Style
+		err := json.Unmarshal([]byte(`{"Name": "StyleColoredDark", "Color": {"Header": ["fg-red"]}}`), &style)
+		assert.Nil(t, err)
+		assert.Equal(t, text.Colors{text.FgRed}, style.Color.Header)
+		assert.Equal(t, text.Colors{text.FgHiCyan, text.BgHiBlack}, StyleColoredDark.Color.Header)
+		registered, _ := StyleByName("StyleColoredDark")
+		assert.Equal(t, StyleColoredDark, registered)
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		var style Style
+		err := json.Unmarshal([]byte(`{"Name": "MyStyle", "Options": {"SeparateRows": true}}`), &style)
+		assert.Nil(t, err)
+
+		expected := StyleDefault
+		expected.Name = "MyStyle"
+		expected.Options.SeparateRows = true
+		assert.Equal(t, expected, style)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var style Style
+		err := json.Unmarshal([]byte(`{"Title": {"Colors": ["fg-purple"]}}`), &style)
+		assert.EqualError(t, err, `invalid Color: "fg-purple"`)
+		assert.NotNil(t, json.Unmarshal([]byte(`[]`), &style))
+	})
+}
+
+func TestStyle_MarshalYAML(t *testing.T) {
+	style := StyleLight
+	style.Box.PageSeparator = "~~" // yaml.v3 drops a lone "\n" value
+	style.Size.WidthMax = 10000000
+
+	b, err := yaml.Marshal(style)
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), "    WidthMax: 10000000\n")
+
+	var style2 Style
+	assert.Nil(t, yaml.Unmarshal(b, &style2))
+	assert.Equal(t, style, style2)
+}
+
+func TestStyle_UnmarshalYAML(t *testing.T) {
+	var config struct {
+		Style Style `yaml:"style"`
+	}
+	err := yaml.Unmarshal([]byte(`
+style:
+  Name: StyleDouble
+  Color:
+    Header: [fg-hi-yellow, "#ff8800"]
+  Size:
+    WidthMax: 80
+`), &config)
+	assert.Nil(t, err)
+
+	expected := StyleDouble
+	expected.Color.Header = text.Colors{text.FgHiYellow, text.FgHex("#ff8800")}
+	expected.Size.WidthMax = 80
+	assert.Equal(t, expected, config.Style)
+
+	err = yaml.Unmarshal([]byte("style: [foo]"), &config)
+	assert.NotNil(t, err)
+}
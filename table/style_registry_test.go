@@ -0,0 +1,40 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterStyle(t *testing.T) {
+	style := StyleLight
+	style.Name = "StyleTestRegistered"
+	style.Options.SeparateRows = true
+	RegisterStyle(style)
+	defer func() {
+		stylesRegisteredMutex.Lock()
+		delete(stylesRegistered, style.Name)
+		stylesRegisteredMutex.Unlock()
+	}()
+
+	registered, ok := StyleByName("StyleTestRegistered")
+	assert.True(t, ok)
+	assert.Equal(t, style, registered)
+	assert.Contains(t, StyleNames(), "StyleTestRegistered")
+}
+
+func TestStyleByName(t *testing.T) {
+	style, ok := StyleByName("StyleRounded")
+	assert.True(t, ok)
+	assert.Equal(t, StyleRounded, style)
+
+	_, ok = StyleByName("StyleFoo")
+	assert.False(t, ok)
+}
+
+func TestStyleNames(t *testing.T) {
+	names := StyleNames()
+	assert.Len(t, names, 19)
+	assert.Equal(t, "StyleBold", names[0])
+	assert.Equal(t, "StyleRounded", names[len(names)-1])
+}
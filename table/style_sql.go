@@ -0,0 +1,33 @@
+package table
+
+// SQLDialect defines the flavor of SQL generated by RenderSQL.
+type SQLDialect string
+
+// Supported SQL dialects.
+const (
+	SQLDialectMySQL    SQLDialect = "mysql"
+	SQLDialectPostgres SQLDialect = "postgres"
+	SQLDialectSQLite   SQLDialect = "sqlite"
+)
+
+// SQLOptions defines options to control SQL rendering.
+type SQLOptions struct {
+	// BatchSize is the maximum number of rows inserted by a single INSERT
+	// statement; default: 100
+	BatchSize int
+	// CreateTable generates a CREATE TABLE statement before the INSERT
+	// statements, with the column types inferred from the values
+	CreateTable bool
+}
+
+var (
+	// DefaultSQLOptions defines sensible SQL rendering defaults.
+	DefaultSQLOptions = SQLOptions{}
+)
+
+func (s SQLOptions) getBatchSize() int {
+	if s.BatchSize > 0 {
+		return s.BatchSize
+	}
+	return sqlBatchSizeDefault
+}
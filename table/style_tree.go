@@ -0,0 +1,31 @@
+package table
+
+import "github.com/jedib0t/go-pretty/v6/list"
+
+// TreeOptions defines the way the rows appended with AppendChildRow are to be
+// rendered in the tree column (refer to ColumnConfig.TreeColumn).
+type TreeOptions struct {
+	// Connectors contains the characters used to connect the rows to their
+	// parents; CharItemMiddle, CharItemBottom and CharItemVertical are used.
+	// Defaults to list.StyleConnectedLight when not set.
+	Connectors list.Style
+	// MaxDepth hides the rows nested deeper than this (1 shows only the
+	// top-level rows), and shows the number of rows hidden under the ones at
+	// this depth (ex.: "(+3 children)"); 0 means no limit.
+	MaxDepth int
+}
+
+var (
+	// TreeOptionsDefault defines sensible tree options.
+	TreeOptionsDefault = TreeOptions{
+		Connectors: list.StyleConnectedLight,
+		MaxDepth:   0,
+	}
+)
+
+func (t TreeOptions) getConnectors() list.Style {
+	if t.Connectors.CharItemMiddle == "" && t.Connectors.CharItemBottom == "" {
+		return TreeOptionsDefault.Connectors
+	}
+	return t.Connectors
+}
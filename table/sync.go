@@ -0,0 +1,504 @@
+package table
+
+import (
+	"io"
+	"sync"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// SyncWriter is a Writer that can be used from multiple goroutines at the same
+// time. All the changes to the table are guarded by a lock, and every render
+// works on a snapshot (see Table.Clone) of the table taken at the beginning of
+// the render; so the table can be appended to while it is being rendered, and
+// rendered by multiple goroutines in parallel.
+//
+// Style() returns a copy of the Style of the table, as changes made through
+// the Style of the table itself would race with the renders; use SetStyle() or
+// UpdateStyle() to change it instead.
+type SyncWriter struct {
+	mutex sync.RWMutex
+	table Table
+}
+
+// NewSyncWriter initializes and returns a SyncWriter.
+func NewSyncWriter() *SyncWriter {
+	return &SyncWriter{}
+}
+
+// AppendChildRow appends the row to the List of rows to render as a child of
+// the row at the given index.
+func (sw *SyncWriter) AppendChildRow(parentIdx int, row Row, configs ...RowConfig) int {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.AppendChildRow(parentIdx, row, configs...)
+}
+
+// AppendFooter appends the row to the List of footers to render.
+func (sw *SyncWriter) AppendFooter(row Row, configs ...RowConfig) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.AppendFooter(row, configs...)
+}
+
+// AppendHeader appends the row to the List of headers to render.
+func (sw *SyncWriter) AppendHeader(row Row, configs ...RowConfig) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.AppendHeader(row, configs...)
+}
+
+// AppendNote appends a row of free text spanning the full width of the table
+// under the current last row.
+func (sw *SyncWriter) AppendNote(note string) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.AppendNote(note)
+}
+
+// AppendRow appends the row to the List of rows to render.
+func (sw *SyncWriter) AppendRow(row Row, configs ...RowConfig) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.AppendRow(row, configs...)
+}
+
+// AppendRows appends the rows to the List of rows to render.
+func (sw *SyncWriter) AppendRows(rows []Row, configs ...RowConfig) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.AppendRows(rows, configs...)
+}
+
+// AppendSection starts a new section of rows with a title.
+func (sw *SyncWriter) AppendSection(title string, colors ...text.Color) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.AppendSection(title, colors...)
+}
+
+// AppendSeparator helps render a separator row after the current last row.
+func (sw *SyncWriter) AppendSeparator() {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.AppendSeparator()
+}
+
+// Clone returns a deep copy of the table as it is right now, which is no
+// longer guarded by the lock.
+func (sw *SyncWriter) Clone() *Table {
+	sw.mutex.RLock()
+	defer sw.mutex.RUnlock()
+
+	return sw.table.Clone()
+}
+
+// DeleteRow removes the row at the given index.
+func (sw *SyncWriter) DeleteRow(idx int) bool {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.DeleteRow(idx)
+}
+
+// Describe returns a new Writer that summarizes the values in each column of
+// a snapshot of the table.
+func (sw *SyncWriter) Describe() Writer {
+	return sw.Clone().Describe()
+}
+
+// FilterBy sets the rules for filtering the Rows.
+func (sw *SyncWriter) FilterBy(filterBy []FilterBy) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.FilterBy(filterBy)
+}
+
+// FindRow returns the index of the first row with the given key in the given
+// column, along with a copy of the row.
+func (sw *SyncWriter) FindRow(keyColumn int, key interface{}) (int, Row) {
+	sw.mutex.RLock()
+	defer sw.mutex.RUnlock()
+
+	return sw.table.FindRow(keyColumn, key)
+}
+
+// FindRowByName works like FindRow, but with the key column given by its name.
+func (sw *SyncWriter) FindRowByName(keyColumn string, key interface{}) (int, Row) {
+	sw.mutex.RLock()
+	defer sw.mutex.RUnlock()
+
+	return sw.table.FindRowByName(keyColumn, key)
+}
+
+// ImportGrid helps import 1d or 2d arrays as rows.
+func (sw *SyncWriter) ImportGrid(grid interface{}) bool {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.ImportGrid(grid)
+}
+
+// InsertRow inserts the row at the given index.
+func (sw *SyncWriter) InsertRow(idx int, row Row, configs ...RowConfig) bool {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.InsertRow(idx, row, configs...)
+}
+
+// Length returns the number of rows to be rendered.
+func (sw *SyncWriter) Length() int {
+	sw.mutex.RLock()
+	defer sw.mutex.RUnlock()
+
+	return sw.table.Length()
+}
+
+// Limit restricts the rows rendered to the first "head" and the last "tail"
+// rows.
+func (sw *SyncWriter) Limit(head int, tail int) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.Limit(head, tail)
+}
+
+// Offset skips the first n rows while rendering.
+func (sw *SyncWriter) Offset(n int) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.Offset(n)
+}
+
+// Pager returns an object that splits a snapshot of the table output into
+// pages and lets you move back and forth through them.
+func (sw *SyncWriter) Pager(opts ...PagerOption) Pager {
+	return sw.Clone().Pager(opts...)
+}
+
+// Render renders a snapshot of the table in a human-readable "pretty" format.
+func (sw *SyncWriter) Render() string {
+	return sw.Clone().Render()
+}
+
+// RenderCSV renders a snapshot of the table in CSV format.
+func (sw *SyncWriter) RenderCSV() string {
+	return sw.Clone().RenderCSV()
+}
+
+// RenderCSVTo renders a snapshot of the table in CSV format to the io.Writer.
+func (sw *SyncWriter) RenderCSVTo(w io.Writer) (int64, error) {
+	return sw.Clone().RenderCSVTo(w)
+}
+
+// RenderHTML renders a snapshot of the table in HTML format.
+func (sw *SyncWriter) RenderHTML() string {
+	return sw.Clone().RenderHTML()
+}
+
+// RenderHTMLTo renders a snapshot of the table in HTML format to the
+// io.Writer.
+func (sw *SyncWriter) RenderHTMLTo(w io.Writer) (int64, error) {
+	return sw.Clone().RenderHTMLTo(w)
+}
+
+// RenderHTMLDocument renders a snapshot of the table in a self-contained HTML
+// page.
+func (sw *SyncWriter) RenderHTMLDocument(opts HTMLDocumentOptions) string {
+	return sw.Clone().RenderHTMLDocument(opts)
+}
+
+// RenderHTMLDocumentTo renders a snapshot of the table in a self-contained
+// HTML page to the io.Writer.
+func (sw *SyncWriter) RenderHTMLDocumentTo(w io.Writer, opts HTMLDocumentOptions) (int64, error) {
+	return sw.Clone().RenderHTMLDocumentTo(w, opts)
+}
+
+// RenderMarkdown renders a snapshot of the table in Markdown format.
+func (sw *SyncWriter) RenderMarkdown() string {
+	return sw.Clone().RenderMarkdown()
+}
+
+// RenderMarkdownTo renders a snapshot of the table in Markdown format to the
+// io.Writer.
+func (sw *SyncWriter) RenderMarkdownTo(w io.Writer) (int64, error) {
+	return sw.Clone().RenderMarkdownTo(w)
+}
+
+// RenderSQL renders a snapshot of the table as SQL statements.
+func (sw *SyncWriter) RenderSQL(tableName string, dialect SQLDialect) string {
+	return sw.Clone().RenderSQL(tableName, dialect)
+}
+
+// RenderSQLTo renders a snapshot of the table as SQL statements to the
+// io.Writer.
+func (sw *SyncWriter) RenderSQLTo(w io.Writer, tableName string, dialect SQLDialect) (int64, error) {
+	return sw.Clone().RenderSQLTo(w, tableName, dialect)
+}
+
+// RenderTo renders a snapshot of the table in a human-readable "pretty"
+// format to the io.Writer.
+func (sw *SyncWriter) RenderTo(w io.Writer) (int64, error) {
+	return sw.Clone().RenderTo(w)
+}
+
+// RenderTSV renders a snapshot of the table in TSV format.
+func (sw *SyncWriter) RenderTSV() string {
+	return sw.Clone().RenderTSV()
+}
+
+// RenderTSVTo renders a snapshot of the table in TSV format to the io.Writer.
+func (sw *SyncWriter) RenderTSVTo(w io.Writer) (int64, error) {
+	return sw.Clone().RenderTSVTo(w)
+}
+
+// RenderVertical renders a snapshot of the table with each row as a record.
+func (sw *SyncWriter) RenderVertical() string {
+	return sw.Clone().RenderVertical()
+}
+
+// RenderVerticalTo renders a snapshot of the table with each row as a record
+// to the io.Writer.
+func (sw *SyncWriter) RenderVerticalTo(w io.Writer) (int64, error) {
+	return sw.Clone().RenderVerticalTo(w)
+}
+
+// ResetFooters resets and clears all the Footer rows appended earlier.
+func (sw *SyncWriter) ResetFooters() {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.ResetFooters()
+}
+
+// ResetHeaders resets and clears all the Header rows appended earlier.
+func (sw *SyncWriter) ResetHeaders() {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.ResetHeaders()
+}
+
+// ResetRows resets and clears all the rows appended earlier.
+func (sw *SyncWriter) ResetRows() {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.ResetRows()
+}
+
+// Rows returns a copy of all the rows appended so far.
+func (sw *SyncWriter) Rows() []Row {
+	sw.mutex.RLock()
+	defer sw.mutex.RUnlock()
+
+	return sw.table.Rows()
+}
+
+// SetAllowedRowLength sets the maximum allowed length or a row.
+//
+// Deprecated: in favor if Style().Size.WidthMax
+func (sw *SyncWriter) SetAllowedRowLength(length int) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetAllowedRowLength(length)
+}
+
+// SetAutoIndex adds a generated header with columns such as "A", "B", "C", etc.
+// and a leading column with the row number.
+func (sw *SyncWriter) SetAutoIndex(autoIndex bool) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetAutoIndex(autoIndex)
+}
+
+// SetCaption sets the text to be rendered just below the table.
+func (sw *SyncWriter) SetCaption(format string, a ...interface{}) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetCaption(format, a...)
+}
+
+// SetColumnConfigs sets the configs for each Column.
+func (sw *SyncWriter) SetColumnConfigs(configs []ColumnConfig) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetColumnConfigs(configs)
+}
+
+// SetColumnGroups sets the titles to be rendered above ranges of columns.
+func (sw *SyncWriter) SetColumnGroups(groups []ColumnGroup) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetColumnGroups(groups)
+}
+
+// SetHTMLCSSClass sets the HTML CSS Class to use on the <table> node.
+//
+// Deprecated: in favor of Style().HTML.CSSClass
+func (sw *SyncWriter) SetHTMLCSSClass(cssClass string) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetHTMLCSSClass(cssClass)
+}
+
+// SetIndexColumn sets the given Column # as the column that has the row
+// "Number".
+func (sw *SyncWriter) SetIndexColumn(colNum int) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetIndexColumn(colNum)
+}
+
+// SetOutputMirror sets an io.Writer for all the Render functions to "Write" to
+// in addition to returning a string.
+func (sw *SyncWriter) SetOutputMirror(mirror io.Writer) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetOutputMirror(mirror)
+}
+
+// SetPageSize sets the maximum number of lines to render before rendering the
+// header rows again.
+//
+// Deprecated: in favor of Pager()
+func (sw *SyncWriter) SetPageSize(numLines int) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetPageSize(numLines)
+}
+
+// SetRowPainter sets up the function which determines the colors to use on a
+// row.
+func (sw *SyncWriter) SetRowPainter(painter interface{}) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetRowPainter(painter)
+}
+
+// SetStrictMode enables or disables the strict mode.
+func (sw *SyncWriter) SetStrictMode(strict bool) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetStrictMode(strict)
+}
+
+// SetStyle overrides the DefaultStyle with the provided one.
+func (sw *SyncWriter) SetStyle(style Style) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetStyle(style)
+}
+
+// SetTitle sets the title text to be rendered above the table.
+func (sw *SyncWriter) SetTitle(format string, a ...interface{}) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SetTitle(format, a...)
+}
+
+// SortBy sets the rules for sorting the Rows in the order specified.
+func (sw *SyncWriter) SortBy(sortBy []SortBy) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SortBy(sortBy)
+}
+
+// Style returns a copy of the current style; changes made to it do not affect
+// the table. Use SetStyle() or UpdateStyle() to change the style.
+func (sw *SyncWriter) Style() *Style {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.Style().clone()
+}
+
+// SuppressEmptyColumns hides columns when the column is empty in ALL the
+// regular rows.
+func (sw *SyncWriter) SuppressEmptyColumns() {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SuppressEmptyColumns()
+}
+
+// SuppressTrailingSpaces removes all trailing spaces from the output.
+func (sw *SyncWriter) SuppressTrailingSpaces() {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	sw.table.SuppressTrailingSpaces()
+}
+
+// UpdateRow replaces the row at the given index.
+func (sw *SyncWriter) UpdateRow(idx int, row Row, configs ...RowConfig) bool {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.UpdateRow(idx, row, configs...)
+}
+
+// UpdateStyle calls the given function with the current style while holding
+// the lock, so the style can be changed in place without racing with the
+// renders. For ex.:
+//
+//	sw.UpdateStyle(func(style *Style) {
+//		style.Options.DrawBorder = false
+//	})
+func (sw *SyncWriter) UpdateStyle(fn func(style *Style)) {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	fn(sw.table.Style())
+}
+
+// UpsertRow replaces the first row with the same value in the given key column
+// as the given row, or appends the row if there is no such row.
+func (sw *SyncWriter) UpsertRow(keyColumn int, row Row, configs ...RowConfig) int {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.UpsertRow(keyColumn, row, configs...)
+}
+
+// UpsertRowByName works like UpsertRow, but with the key column given by its
+// name.
+func (sw *SyncWriter) UpsertRowByName(keyColumn string, row Row, configs ...RowConfig) int {
+	sw.mutex.Lock()
+	defer sw.mutex.Unlock()
+
+	return sw.table.UpsertRowByName(keyColumn, row, configs...)
+}
+
+// Validate checks the settings of the table; see Table.Validate.
+func (sw *SyncWriter) Validate() error {
+	sw.mutex.RLock()
+	defer sw.mutex.RUnlock()
+
+	return sw.table.Validate()
+}
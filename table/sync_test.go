@@ -0,0 +1,193 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSyncWriter(t *testing.T) {
+	var tw Writer = NewSyncWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendFooter(testFooter)
+	tw.SetTitle(testTitle1)
+	tw.SetCaption(testCaption)
+
+	expected := &Table{}
+	expected.AppendHeader(testHeader)
+	expected.AppendRows(testRows)
+	expected.AppendFooter(testFooter)
+	expected.SetTitle(testTitle1)
+	expected.SetCaption(testCaption)
+
+	assert.Equal(t, 3, tw.Length())
+	assert.Equal(t, expected.Render(), tw.Render())
+	assert.Equal(t, expected.RenderCSV(), tw.RenderCSV())
+	assert.Equal(t, expected.RenderHTML(), tw.RenderHTML())
+	assert.Equal(t, expected.RenderMarkdown(), tw.RenderMarkdown())
+	assert.Equal(t, expected.RenderTSV(), tw.RenderTSV())
+	assert.Equal(t, expected.RenderVertical(), tw.RenderVertical())
+}
+
+func TestSyncWriter_Concurrency(t *testing.T) {
+	tw := NewSyncWriter()
+	tw.AppendHeader(testHeader)
+	tw.SetStyle(StyleLight)
+	tw.SortBy([]SortBy{{Name: "Salary", Mode: DscNumeric}})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Salary", Transformer: text.NewNumberTransformer("%d")}})
+
+	numAppenders, numRenderers, numRowsPerAppender := 4, 4, 50
+	var wg sync.WaitGroup
+	for idx := 0; idx < numAppenders; idx++ {
+		wg.Add(1)
+		go func(appender int) {
+			defer wg.Done()
+			for rowIdx := 0; rowIdx < numRowsPerAppender; rowIdx++ {
+				tw.AppendRow(Row{appender, fmt.Sprintf("Name %d", rowIdx), "Stark", rowIdx * 100})
+				if rowIdx%10 == 0 {
+					tw.AppendSeparator()
+				}
+			}
+		}(idx)
+	}
+	for idx := 0; idx < numRenderers; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for renderIdx := 0; renderIdx < 10; renderIdx++ {
+				assert.NotEmpty(t, tw.Render())
+				assert.NotEmpty(t, tw.RenderCSV())
+				var out strings.Builder
+				_, err := tw.RenderTo(&out)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, tw.Pager(PageSize(5)).Render())
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, numAppenders*numRowsPerAppender, tw.Length())
+	assert.Equal(t, numAppenders*numRowsPerAppender, strings.Count(tw.Render(), "Stark"))
+}
+
+func TestSyncWriter_Style(t *testing.T) {
+	tw := NewSyncWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.SetStyle(StyleLight)
+
+	// the style returned is a copy, and changing it does not affect the table
+	style := tw.Style()
+	style.Options.DrawBorder = false
+	style.Box.TopLeft = "="
+	assert.True(t, tw.Style().Options.DrawBorder)
+	assert.Equal(t, "┌", tw.Style().Box.TopLeft)
+
+	// while the changes made using UpdateStyle do not race with the renders
+	var wg sync.WaitGroup
+	for idx := 0; idx < 4; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for renderIdx := 0; renderIdx < 20; renderIdx++ {
+				assert.NotEmpty(t, tw.Render())
+			}
+		}()
+	}
+	for idx := 0; idx < 20; idx++ {
+		tw.UpdateStyle(func(style *Style) {
+			style.Options.DrawBorder = !style.Options.DrawBorder
+			style.Color.Header = text.Colors{text.FgRed}
+		})
+	}
+	wg.Wait()
+	assert.True(t, tw.Style().Options.DrawBorder)
+	assert.Equal(t, text.Colors{text.FgRed}, tw.Style().Color.Header)
+}
+
+func TestTable_Clone(t *testing.T) {
+	tw := &Table{}
+	tw.AppendHeader(testHeader, RowConfig{AutoMerge: true})
+	tw.AppendRow(testRows[0], RowConfig{AutoMerge: true})
+	tw.AppendSeparator()
+	tw.AppendRows(testRows[1:])
+	tw.AppendFooter(testFooter)
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Salary", Align: text.AlignCenter}})
+	tw.SortBy([]SortBy{{Name: "Salary"}})
+	tw.FilterBy([]FilterBy{{Name: "Salary", Operator: LessThan, Value: 5000}})
+	tw.SetStyle(StyleLight)
+	tw.SetTitle(testTitle1)
+	tw.SetCaption(testCaption)
+	expectedOut := tw.Render()
+
+	clone := tw.Clone()
+	assert.Equal(t, expectedOut, clone.Render())
+
+	// changes to the clone do not affect the original
+	clone.AppendRow(Row{4000, "Bran", "Stark", 1000})
+	clone.UpdateRow(0, Row{1, "Sansa", "Stark", 3000}, RowConfig{})
+	clone.AppendHeader(testHeader)
+	clone.AppendFooter(testFooter)
+	clone.columnConfigs[0].Align = text.AlignLeft
+	clone.sortBy[0].Mode = Dsc
+	clone.filterBy[0].Value = 4000
+	clone.Style().Options.DrawBorder = false
+	clone.SetTitle(testTitle2)
+	assert.NotEqual(t, expectedOut, clone.Render())
+	assert.Equal(t, expectedOut, tw.Render())
+
+	// and vice versa
+	cloneOut := clone.Render()
+	tw.ResetRows()
+	tw.Style().Box = StyleBoxDefault
+	assert.Equal(t, cloneOut, clone.Render())
+}
+
+func TestTable_Clone_Style(t *testing.T) {
+	tw := &Table{}
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 1, Colors: text.Colors{text.FgRed}}})
+	tw.SetStyle(StyleLight)
+	tw.Style().Color.Header = text.Colors{text.FgRed}
+	tw.Style().Title.Colors = text.Colors{text.FgRed}
+	expectedOut := tw.Render()
+
+	// changes to the colors and the box of the clone do not affect the original
+	clone := tw.Clone()
+	clone.Style().Color.Header[0] = text.FgBlue
+	clone.Style().Title.Colors[0] = text.FgBlue
+	clone.Style().Box.Horizontal.RowTop = "="
+	clone.columnConfigs[0].Colors[0] = text.FgBlue
+	assert.NotEqual(t, expectedOut, clone.Render())
+	assert.Equal(t, text.Colors{text.FgRed}, tw.Style().Color.Header)
+	assert.Equal(t, text.Colors{text.FgRed}, tw.Style().Title.Colors)
+	assert.Equal(t, "─", tw.Style().Box.Horizontal.RowTop)
+	assert.Equal(t, text.Colors{text.FgRed}, tw.columnConfigs[0].Colors)
+	assert.Equal(t, expectedOut, tw.Render())
+}
+
+func TestTable_Clone_Race(t *testing.T) {
+	tw := &Table{}
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.SetStyle(StyleLight)
+
+	var wg sync.WaitGroup
+	for idx := 0; idx < 4; idx++ {
+		clone := tw.Clone()
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			clone.AppendRow(Row{idx})
+			clone.Style().Options.SeparateRows = true
+			assert.NotEmpty(t, clone.Render())
+		}(idx)
+	}
+	wg.Wait()
+}
@@ -3,6 +3,8 @@ package table
 import (
 	"fmt"
 	"io"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -21,6 +23,11 @@ type Table struct {
 	// caption stores the text to be rendered just below the table; and doesn't
 	// get used when rendered as a CSV
 	caption string
+	// columnGroups stores the titles to render above ranges of columns
+	columnGroups []ColumnGroup
+	// columnGroupRows stores the header rows generated from columnGroups (and
+	// prepended to rowsHeader) before rendering
+	columnGroupRows []columnGroupRow
 	// columnIsNonNumeric stores if a column contains non-numbers in all rows
 	columnIsNonNumeric []bool
 	// columnConfigs stores the custom-configuration for 1 or more columns
@@ -32,12 +39,24 @@ type Table struct {
 	directionModifier string
 	// firstRowOfPage tells if the renderer is on the first row of a page?
 	firstRowOfPage bool
+	// highlightedCells maps the cells (in the rows being rendered) to be
+	// highlighted to the colors to use on them; used by LiveWriter
+	highlightedCells map[cellPosition]text.Colors
 	// htmlCSSClass stores the HTML CSS Class to use on the <table> node
 	htmlCSSClass string
+	// htmlDocument tells if the table is being rendered in an HTML document
+	// (see RenderHTMLDocument) with the accessibility attributes
+	htmlDocument bool
+	// htmlSafeCells stores the position of the cells that were generated as
+	// HTML-safe content by a cellRenderer and should not be escaped again
+	htmlSafeCells map[htmlCellPosition]bool
 	// indexColumn stores the number of the column considered as the "index"
 	indexColumn int
 	// maxColumnLengths stores the length of the longest line in each column
 	maxColumnLengths []int
+	// maxDecimalLengths stores the length of the longest integer and fraction
+	// parts in each column aligned with text.AlignDecimal
+	maxDecimalLengths map[int]decimalLengths
 	// maxMergedColumnLengths stores the longest lengths for merged columns
 	// endIndex -> startIndex -> maxMergedLength
 	maxMergedColumnLengths map[int]map[int]int
@@ -45,6 +64,9 @@ type Table struct {
 	maxRowLength int
 	// numColumns stores the (max.) number of columns seen
 	numColumns int
+	// notes stores the note rows to render after each row (keyed by the index
+	// in rowsRaw, with -1 for the ones appended before any row)
+	notes map[int][]string
 	// numLinesRendered keeps track of the number of lines rendered and helps in
 	// paginating long tables
 	numLinesRendered int
@@ -52,19 +74,44 @@ type Table struct {
 	outputMirror io.Writer
 	// pager controls how the output is separated into pages
 	pager pager
+	// renderTarget is the io.Writer to render to in chunks (set only during
+	// calls to RenderTo and its variants)
+	renderTarget *renderTarget
 	// renderMode contains the type of table to render
 	renderMode renderMode
 	// rows stores the rows that make up the body (in string form)
 	rows []rowStr
+	// rowsLimitHead stores the number of rows to render from the top
+	rowsLimitHead int
+	// rowsLimitTail stores the number of rows to render from the bottom
+	rowsLimitTail int
+	// rowsOffset stores the number of rows to skip from the top
+	rowsOffset int
+	// rowsOmitted stores the number of rows left out as per Limit
+	rowsOmitted int
+	// rowsOmittedIdx stores the index of the row in rows above which the
+	// omission row is to be rendered
+	rowsOmittedIdx int
+	// rowsNotes stores the note rows to render after each row in rows (with
+	// -1 for the ones to render above all the rows)
+	rowsNotes map[int][]string
+	// rowsSections stores the section rows to render above each row in rows
+	rowsSections map[int]*rowSection
 	// rowsColors stores the text.Colors over-rides for each row as defined by
 	// rowPainter or rowPainterWithAttributes
 	rowsColors []text.Colors
 	// rowsConfigs stores RowConfig for each row
 	rowsConfigMap map[int]RowConfig
+	// rowsParentMap stores the index of the parent row for each row appended
+	// with AppendChildRow
+	rowsParentMap map[int]int
 	// rowsRaw stores the rows that make up the body
 	rowsRaw []Row
 	// rowsRawFiltered is the filtered version of rowsRaw
 	rowsRawFiltered []Row
+	// rowsRawFilteredIndices maps each row in rowsRawFiltered to its index in
+	// rowsRaw (nil if no rows were filtered out)
+	rowsRawFilteredIndices []int
 	// rowsFooter stores the rows that make up the footer (in string form)
 	rowsFooter []rowStr
 	// rowsFooterConfigs stores RowConfig for each footer row
@@ -82,20 +129,30 @@ type Table struct {
 	rowPainter RowPainter
 	// rowPainterWithAttributes is same as rowPainter, but with attributes
 	rowPainterWithAttributes RowPainterWithAttributes
+	// rowPainterUnsupported stores the type of the painter given to
+	// SetRowPainter if it is not supported, to be reported by Validate
+	rowPainterUnsupported string
 	// rowSeparators contains the separator columns (dashes that make up the
 	// separators between title/header/body/footer
 	rowSeparators map[string]rowStr
 	// rowSeparatorStrings contains the separator strings for each separator type
 	rowSeparatorStrings map[separatorType]string
+	// sections stores the section rows, in the order they were appended
+	sections []rowSection
 	// separators is used to keep track of all rowIndices after which a
 	// separator has to be rendered
 	separators map[int]bool
+	// separatorsRendered is the same as separators, with the row indices in
+	// rows as left after Offset and Limit
+	separatorsRendered map[int]bool
 	// sortBy stores a map of Column
 	sortBy []SortBy
 	// sortedRowIndices is the output of sorting
 	sortedRowIndices []int
 	// filterBy stores the filter criteria
 	filterBy []FilterBy
+	// strictMode makes the Render functions fail if Validate returns an error
+	strictMode bool
 	// style contains all the strings used to draw the table, and more
 	style *Style
 	// suppressEmptyColumns hides columns which have no content on all regular
@@ -105,6 +162,32 @@ type Table struct {
 	suppressTrailingSpaces bool
 	// title contains the text to appear above the table
 	title string
+	// virtualColumnNames maps the index of each virtual (computed) column not
+	// present in the input to its name
+	virtualColumnNames map[int]string
+}
+
+// AppendChildRow appends the row to the List of rows to render as a child of
+// the row at the given index (0-based, in the order the rows were appended;
+// refer to FindRow). The child rows are rendered right after their parents,
+// with the tree column (refer to ColumnConfig.TreeColumn) showing the
+// connectors between them. Returns the index of the appended row, or -1 if
+// there is no row at the given index.
+//
+// When sorting, the rows are sorted among their siblings. When filtering, the
+// ancestors of the matching rows are retained.
+//
+// Only the first item in the "config" will be tagged against this row.
+func (t *Table) AppendChildRow(parentIdx int, row Row, config ...RowConfig) int {
+	if parentIdx < 0 || parentIdx >= len(t.rowsRaw) {
+		return -1
+	}
+	t.AppendRow(row, config...)
+	if t.rowsParentMap == nil {
+		t.rowsParentMap = make(map[int]int)
+	}
+	t.rowsParentMap[len(t.rowsRaw)-1] = parentIdx
+	return len(t.rowsRaw) - 1
 }
 
 // AppendFooter appends the row to the List of footers to render.
@@ -133,15 +216,31 @@ func (t *Table) AppendHeader(row Row, config ...RowConfig) {
 	}
 }
 
+// AppendNote appends a row of free text spanning the full width of the table
+// (ex.: an error message) under the current last row. The note moves along
+// with the row on sorting, and is not rendered if the row is filtered out. If
+// no rows have been appended since the last call to AppendSection, the note is
+// rendered under the section title instead.
+//
+// Notes are not rendered in CSV, TSV and vertical modes.
+func (t *Table) AppendNote(note string) {
+	if numSections := len(t.sections); numSections > 0 && t.sections[numSections-1].rowIdx == len(t.rowsRaw) {
+		t.sections[numSections-1].notes = append(t.sections[numSections-1].notes, note)
+		return
+	}
+	if t.notes == nil {
+		t.notes = make(map[int][]string)
+	}
+	t.notes[len(t.rowsRaw)-1] = append(t.notes[len(t.rowsRaw)-1], note)
+}
+
 // AppendRow appends the row to the List of rows to render.
 //
 // Only the first item in the "config" will be tagged against this row.
 func (t *Table) AppendRow(row Row, config ...RowConfig) {
 	t.rowsRawFiltered = append(t.rowsRawFiltered, row)
 	// Keep original rows in sync for filtering
-	rowCopy := make(Row, len(row))
-	copy(rowCopy, row)
-	t.rowsRaw = append(t.rowsRaw, rowCopy)
+	t.rowsRaw = append(t.rowsRaw, copyRow(row))
 	if len(config) > 0 {
 		if t.rowsConfigMap == nil {
 			t.rowsConfigMap = make(map[int]RowConfig)
@@ -159,6 +258,23 @@ func (t *Table) AppendRows(rows []Row, config ...RowConfig) {
 	}
 }
 
+// AppendSection starts a new section of rows with a title, that is rendered in
+// a separator row spanning the full width of the table (ex.:
+// "├── Production ──────┤") above the rows appended after this call. The
+// section stays in place on sorting, with the rows being sorted within each
+// section. Sections without any rows to render are left out.
+//
+// Sections are rendered as header cells spanning all the columns in HTML mode,
+// as bold text in Markdown mode, and are not rendered in CSV, TSV and vertical
+// modes.
+func (t *Table) AppendSection(title string, colors ...text.Color) {
+	t.sections = append(t.sections, rowSection{
+		colors: colors,
+		rowIdx: len(t.rowsRaw),
+		title:  title,
+	})
+}
+
 // AppendSeparator helps render a separator row after the current last row. You
 // could call this function over and over, but it will be a no-op unless you
 // call AppendRow or AppendRows in between. Likewise, if the last thing you
@@ -183,12 +299,109 @@ func (t *Table) AppendSeparator() {
 	}
 }
 
+// Clone returns a deep copy of the Table with all the rows and settings, that
+// can be changed and rendered independently of the original. The values in the
+// rows, and the functions (Transformers, painters, etc.) are shared.
+func (t *Table) Clone() *Table {
+	clone := *t
+	clone.reset()
+	clone.highlightedCells = nil
+	clone.pager = pager{outputMirror: t.pager.outputMirror, size: t.pager.size}
+	clone.renderTarget = nil
+	clone.virtualColumnNames = nil
+
+	clone.columnConfigs = append([]ColumnConfig(nil), t.columnConfigs...)
+	for idx, colCfg := range clone.columnConfigs {
+		clone.columnConfigs[idx].Colors = copyColors(colCfg.Colors)
+		clone.columnConfigs[idx].ColorsFooter = copyColors(colCfg.ColorsFooter)
+		clone.columnConfigs[idx].ColorsHeader = copyColors(colCfg.ColorsHeader)
+	}
+	clone.columnGroups = append([]ColumnGroup(nil), t.columnGroups...)
+	for idx, group := range clone.columnGroups {
+		clone.columnGroups[idx].Colors = copyColors(group.Colors)
+	}
+	clone.filterBy = append([]FilterBy(nil), t.filterBy...)
+	clone.rowsConfigMap = copyRowConfigMap(t.rowsConfigMap)
+	clone.rowsFooterConfigMap = copyRowConfigMap(t.rowsFooterConfigMap)
+	if t.rowsParentMap != nil {
+		clone.rowsParentMap = make(map[int]int, len(t.rowsParentMap))
+		for rowIdx, parentIdx := range t.rowsParentMap {
+			clone.rowsParentMap[rowIdx] = parentIdx
+		}
+	}
+	clone.rowsFooterRaw = copyRows(t.rowsFooterRaw)
+	clone.rowsHeaderConfigMap = copyRowConfigMap(t.rowsHeaderConfigMap)
+	clone.rowsHeaderRaw = copyRows(t.rowsHeaderRaw)
+	clone.rowsRaw = copyRows(t.rowsRaw)
+	clone.rowsRawFiltered = copyRows(t.rowsRawFiltered)
+	clone.sections = copySections(t.sections)
+	if t.notes != nil {
+		clone.notes = make(map[int][]string, len(t.notes))
+		for rowIdx, notes := range t.notes {
+			clone.notes[rowIdx] = append([]string(nil), notes...)
+		}
+	}
+	clone.sortBy = append([]SortBy(nil), t.sortBy...)
+	if t.separators != nil {
+		clone.separators = make(map[int]bool, len(t.separators))
+		for rowIdx, sep := range t.separators {
+			clone.separators[rowIdx] = sep
+		}
+	}
+	if t.style != nil {
+		clone.style = t.style.clone()
+	}
+	return &clone
+}
+
+// DeleteRow removes the row at the given index (0-based, in the order the rows
+// were appended). The configs and separators tagged against the rows that
+// follow move along with them, and a separator that followed the removed row
+// is moved to the row before it. Returns false if the index is out of range.
+func (t *Table) DeleteRow(idx int) bool {
+	if idx < 0 || idx >= len(t.rowsRaw) {
+		return false
+	}
+	hadSeparator := t.separators[idx]
+	t.rowsRaw = append(t.rowsRaw[:idx], t.rowsRaw[idx+1:]...)
+	t.syncRowsRawFiltered()
+	t.shiftRowIndices(idx, -1)
+	if hadSeparator && idx > 0 && idx < len(t.rowsRaw) {
+		t.separators[idx-1] = true
+	}
+	return true
+}
+
 // FilterBy sets the rules for filtering the Rows. All filters are applied with
 // AND logic (all must match). Filters are applied before sorting.
 func (t *Table) FilterBy(filterBy []FilterBy) {
 	t.filterBy = filterBy
 }
 
+// FindRow returns the index of the first row with the given key in the given
+// column (1-based, like ColumnConfig.Number), along with a copy of the row.
+// Returns -1 and nil if no such row exists. The values are compared using
+// reflect.DeepEqual, and so 1 and int64(1) are not considered equal.
+func (t *Table) FindRow(keyColumn int, key interface{}) (int, Row) {
+	colIdx := keyColumn - 1
+	if colIdx < 0 {
+		return -1, nil
+	}
+	for rowIdx, row := range t.rowsRaw {
+		if colIdx < len(row) && reflect.DeepEqual(row[colIdx], key) {
+			return rowIdx, copyRow(row)
+		}
+	}
+	return -1, nil
+}
+
+// FindRowByName works like FindRow, but with the key column given by its name,
+// as it appears in the first Header row, or as A, B, C, etc. without one (like
+// in Diff and Join). Returns -1 and nil if there is no such column.
+func (t *Table) FindRowByName(keyColumn string, key interface{}) (int, Row) {
+	return t.FindRow(t.getColumnNumberByName(keyColumn, nil), key)
+}
+
 // ImportGrid helps import 1d or 2d arrays as rows.
 func (t *Table) ImportGrid(grid interface{}) bool {
 	rows := objAsSlice(grid)
@@ -208,11 +421,63 @@ func (t *Table) ImportGrid(grid interface{}) bool {
 	return addedRows
 }
 
+// InsertRow inserts the row at the given index (0-based, in the order the rows
+// were appended), moving the row at that index and the ones following it down
+// by one. An index equal to the number of rows appends the row. The configs
+// and separators tagged against the moved rows move along with them.
+//
+// Only the first item in the "config" will be tagged against this row. Returns
+// false if the index is out of range.
+func (t *Table) InsertRow(idx int, row Row, config ...RowConfig) bool {
+	if idx < 0 || idx > len(t.rowsRaw) {
+		return false
+	}
+	t.rowsRaw = append(t.rowsRaw, nil)
+	copy(t.rowsRaw[idx+1:], t.rowsRaw[idx:])
+	t.rowsRaw[idx] = copyRow(row)
+	t.syncRowsRawFiltered()
+	t.shiftRowIndices(idx, 1)
+	if len(config) > 0 {
+		if t.rowsConfigMap == nil {
+			t.rowsConfigMap = make(map[int]RowConfig)
+		}
+		t.rowsConfigMap[idx] = config[0]
+	}
+	return true
+}
+
 // Length returns the number of rows to be rendered.
 func (t *Table) Length() int {
 	return len(t.rowsRawFiltered)
 }
 
+// Limit restricts the rows rendered to the first "head" and the last "tail"
+// rows (after filtering, sorting and Offset), with a row spanning all the
+// columns rendered in place of the ones left out (ex.:
+// "… 12,345 rows omitted …"). Either of them can be 0 to render only the first
+// or the last rows, and setting both to 0 removes the limit.
+//
+// The omission row is not rendered in CSV, TSV and vertical modes.
+func (t *Table) Limit(head int, tail int) {
+	if head < 0 {
+		head = 0
+	}
+	if tail < 0 {
+		tail = 0
+	}
+	t.rowsLimitHead = head
+	t.rowsLimitTail = tail
+}
+
+// Offset skips the first n rows (after filtering and sorting) while rendering;
+// unlike the ones left out by Limit, these are skipped silently.
+func (t *Table) Offset(n int) {
+	if n < 0 {
+		n = 0
+	}
+	t.rowsOffset = n
+}
+
 // Pager returns an object that splits the table output into pages and
 // lets you move back and forth through them.
 func (t *Table) Pager(opts ...PagerOption) Pager {
@@ -253,9 +518,22 @@ func (t *Table) ResetHeaders() {
 func (t *Table) ResetRows() {
 	t.rowsRawFiltered = nil
 	t.rowsRaw = nil
+	t.notes = nil
+	t.rowsParentMap = nil
+	t.sections = nil
 	t.separators = nil
 }
 
+// Rows returns a copy of all the rows appended so far (before filtering or
+// sorting), in the order they were appended.
+func (t *Table) Rows() []Row {
+	rows := make([]Row, len(t.rowsRaw))
+	for idx, row := range t.rowsRaw {
+		rows[idx] = copyRow(row)
+	}
+	return rows
+}
+
 // SetAllowedRowLength sets the maximum allowed length or a row (or line of
 // output) when rendered as a table. Rows that are longer than this limit will
 // be "snipped" to the length. Length has to be a positive value to take effect.
@@ -284,6 +562,20 @@ func (t *Table) SetColumnConfigs(configs []ColumnConfig) {
 	t.columnConfigs = configs
 }
 
+// SetColumnGroups sets the titles to be rendered above ranges of columns, in
+// header rows of their own above the regular header rows. Unlike header rows
+// with RowConfig.AutoMerge, adjacent groups with the same title are not merged
+// together. Groups may be nested, in which case the wider ones are rendered
+// above the narrower ones.
+//
+// In HTML, the titles use "colspan" to span the columns. In CSV and TSV, the
+// titles are repeated for each column in the group. In Markdown, which allows
+// only one header row, the titles are prefixed to the column names in the first
+// header row (ex.: "Q1 / Jan").
+func (t *Table) SetColumnGroups(groups []ColumnGroup) {
+	t.columnGroups = groups
+}
+
 // SetHTMLCSSClass sets the HTML CSS Class to use on the <table> node
 // when rendering the Table in HTML format.
 //
@@ -324,6 +616,7 @@ func (t *Table) SetRowPainter(painter interface{}) {
 	// reset both so only one is set at any given time
 	t.rowPainter = nil
 	t.rowPainterWithAttributes = nil
+	t.rowPainterUnsupported = ""
 
 	// if called as SetRowPainter(RowPainter(func...))
 	switch p := painter.(type) {
@@ -344,6 +637,18 @@ func (t *Table) SetRowPainter(painter interface{}) {
 		t.rowPainterWithAttributes = painter.(func(row Row, attr RowAttributes) text.Colors)
 		return
 	}
+
+	if painter != nil {
+		t.rowPainterUnsupported = fmt.Sprintf("%T", painter)
+	}
+}
+
+// SetStrictMode enables or disables the strict mode. In strict mode, the
+// Render*() functions panic with the error returned by Validate() if the
+// Table has been set up incorrectly, and the RenderTo*() functions return it
+// without rendering anything.
+func (t *Table) SetStrictMode(strict bool) {
+	t.strictMode = strict
 }
 
 // SetStyle overrides the DefaultStyle with the provided one.
@@ -388,6 +693,143 @@ func (t *Table) SuppressTrailingSpaces() {
 	t.suppressTrailingSpaces = true
 }
 
+// UpdateRow replaces the row at the given index (0-based, in the order the
+// rows were appended). The config tagged against the row is retained unless
+// a new one is given; only the first item in the "config" will be used.
+// Returns false if the index is out of range.
+func (t *Table) UpdateRow(idx int, row Row, config ...RowConfig) bool {
+	if idx < 0 || idx >= len(t.rowsRaw) {
+		return false
+	}
+	t.rowsRaw[idx] = copyRow(row)
+	t.syncRowsRawFiltered()
+	if len(config) > 0 {
+		if t.rowsConfigMap == nil {
+			t.rowsConfigMap = make(map[int]RowConfig)
+		}
+		t.rowsConfigMap[idx] = config[0]
+	}
+	return true
+}
+
+// UpsertRow replaces the first row with the same value in the given key
+// column (1-based, like ColumnConfig.Number) as the given row, or appends the
+// row if there is no such row. Returns the index of the updated or appended
+// row, or -1 if the row does not have the key column.
+//
+// Only the first item in the "config" will be tagged against this row.
+func (t *Table) UpsertRow(keyColumn int, row Row, config ...RowConfig) int {
+	colIdx := keyColumn - 1
+	if colIdx < 0 || colIdx >= len(row) {
+		return -1
+	}
+	if idx, _ := t.FindRow(keyColumn, row[colIdx]); idx >= 0 {
+		t.UpdateRow(idx, row, config...)
+		return idx
+	}
+	t.AppendRow(row, config...)
+	return len(t.rowsRaw) - 1
+}
+
+// UpsertRowByName works like UpsertRow, but with the key column given by its
+// name; refer to FindRowByName for how the name is looked up. Returns -1 if
+// there is no such column.
+func (t *Table) UpsertRowByName(keyColumn string, row Row, config ...RowConfig) int {
+	return t.UpsertRow(t.getColumnNumberByName(keyColumn, row), row, config...)
+}
+
+// getColumnNumberByName returns the number (1-based) of the column with the
+// given name as looked up by FindRowByName, considering the given row to be
+// part of the table as well. Returns 0 if there is no such column.
+func (t *Table) getColumnNumberByName(name string, row Row) int {
+	var header Row
+	if len(t.rowsHeaderRaw) > 0 {
+		header = t.rowsHeaderRaw[0]
+	}
+	for colIdx, column := range joinColumnNames(header, append([]Row{row}, t.rowsRaw...)) {
+		if column == name {
+			return colIdx + 1
+		}
+	}
+	return 0
+}
+
+// shiftRowIndices moves the configs, separators, notes and parents tagged
+// against the rows at or after the given index by delta, dropping the ones
+// tagged against the row at the given index if delta is negative (i.e., the row
+// was deleted). The children of a deleted row are moved to its parent, and a
+// row inserted at the start of a section becomes part of it.
+func (t *Table) shiftRowIndices(idx int, delta int) {
+	shift := func(rowIdx int) (int, bool) {
+		if rowIdx < idx {
+			return rowIdx, true
+		} else if delta < 0 && rowIdx == idx {
+			return 0, false
+		}
+		return rowIdx + delta, true
+	}
+
+	if t.rowsConfigMap != nil {
+		rowsConfigMap := make(map[int]RowConfig, len(t.rowsConfigMap))
+		for rowIdx, config := range t.rowsConfigMap {
+			if newIdx, ok := shift(rowIdx); ok {
+				rowsConfigMap[newIdx] = config
+			}
+		}
+		t.rowsConfigMap = rowsConfigMap
+	}
+	if t.separators != nil {
+		separators := make(map[int]bool, len(t.separators))
+		for rowIdx, sep := range t.separators {
+			if newIdx, ok := shift(rowIdx); ok {
+				separators[newIdx] = sep
+			}
+		}
+		t.separators = separators
+	}
+	if t.notes != nil {
+		notes := make(map[int][]string, len(t.notes))
+		for rowIdx, rowNotes := range t.notes {
+			if newIdx, ok := shift(rowIdx); ok {
+				notes[newIdx] = rowNotes
+			}
+		}
+		t.notes = notes
+	}
+	for sectionIdx := range t.sections {
+		if t.sections[sectionIdx].rowIdx > idx {
+			t.sections[sectionIdx].rowIdx += delta
+		}
+	}
+	if t.rowsParentMap != nil {
+		rowsParentMap := make(map[int]int, len(t.rowsParentMap))
+		for rowIdx, parentIdx := range t.rowsParentMap {
+			newIdx, ok := shift(rowIdx)
+			if !ok {
+				continue
+			}
+			newParentIdx, ok := shift(parentIdx)
+			if !ok {
+				if parentIdx, ok = t.rowsParentMap[parentIdx]; !ok {
+					continue // the parent was a top-level row
+				}
+				newParentIdx, _ = shift(parentIdx)
+			}
+			rowsParentMap[newIdx] = newParentIdx
+		}
+		t.rowsParentMap = rowsParentMap
+	}
+}
+
+// syncRowsRawFiltered resets the filtered rows to the raw rows after they
+// have been modified; they get filtered again during the next render.
+func (t *Table) syncRowsRawFiltered() {
+	t.rowsRawFiltered = make([]Row, len(t.rowsRaw))
+	for idx, row := range t.rowsRaw {
+		t.rowsRawFiltered[idx] = copyRow(row)
+	}
+}
+
 // calculateNumColumnsFromRaw calculates the number of columns from raw rows and headers
 func (t *Table) calculateNumColumnsFromRaw() {
 	t.numColumns = 0
@@ -413,6 +855,72 @@ func (t *Table) calculateNumColumnsFromRaw() {
 	}
 }
 
+// cloneFiltered returns a copy of the Table with the column configs resolved
+// and the rows filtered (with the computed columns filled in) the way they'd
+// be rendered, along with the first header row (with the names of the virtual
+// columns). This is used to derive new tables (ex.: Describe, Join) from it.
+func (t *Table) cloneFiltered() (*Table, Row) {
+	tc := t.Clone()
+	tc.Style()
+	tc.initForRenderColumnConfigs()
+	tc.initForRenderFilterRows()
+
+	var header Row
+	if rowsHeader := tc.initForRenderRowsHeaderRaw(); len(rowsHeader) > 0 {
+		header = rowsHeader[0]
+	}
+	return tc, header
+}
+
+// getComputedColumnIndices returns the indices of the computed columns in
+// order, so that a computed column can make use of the ones to its left.
+func (t *Table) getComputedColumnIndices() []int {
+	var colIndices []int
+	for colIdx, colCfg := range t.columnConfigMap {
+		if colCfg.Compute != nil {
+			colIndices = append(colIndices, colIdx)
+		}
+	}
+	sort.Ints(colIndices)
+	return colIndices
+}
+
+// getNumColumnsRaw returns the (max.) number of columns in the input rows.
+func (t *Table) getNumColumnsRaw() int {
+	numColumns := 0
+	for _, rows := range [][]Row{t.rowsHeaderRaw, t.rowsRaw, t.rowsFooterRaw} {
+		for _, row := range rows {
+			if len(row) > numColumns {
+				numColumns = len(row)
+			}
+		}
+	}
+	return numColumns
+}
+
+// getVirtualColumnNumber returns the number of the virtual (computed) column
+// with the given name, or 0 if there is none.
+func (t *Table) getVirtualColumnNumber(name string) int {
+	for colIdx, colName := range t.virtualColumnNames {
+		if colName == name {
+			return colIdx + 1
+		}
+	}
+	return 0
+}
+
+// applyAlign aligns the column's text, lining up the numbers in the regular
+// rows on the decimal separator when the alignment is text.AlignDecimal.
+func (t *Table) applyAlign(colIdx int, colStr string, align text.Align, maxLength int, hint renderHint) string {
+	if align == text.AlignDecimal && hint.isRegularRow() {
+		if lengths, ok := t.maxDecimalLengths[colIdx]; ok {
+			separator := t.columnConfigMap[colIdx].DecimalSeparator
+			return text.ApplyDecimal(colStr, separator, lengths.integer, lengths.fraction, maxLength)
+		}
+	}
+	return align.Apply(colStr, maxLength)
+}
+
 func (t *Table) getAlign(colIdx int, hint renderHint) text.Align {
 	align := text.AlignDefault
 	if cfg, ok := t.columnConfigMap[colIdx]; ok {
@@ -509,12 +1017,40 @@ func (t *Table) getBorderRight(hint renderHint) string {
 	return border
 }
 
+// getCellColors returns the colors to render the cell with: the ones for the
+// column if any, or else the ones in the Style for the type of the row.
+func (t *Table) getCellColors(colIdx int, hint renderHint) text.Colors {
+	if colors := t.getColumnColors(colIdx, hint); colors != nil {
+		return colors
+	} else if hint.isHeaderRow {
+		return t.style.Color.Header
+	} else if hint.isFooterRow {
+		return t.style.Color.Footer
+	} else if hint.isRegularRow() {
+		if colIdx == t.indexColumn-1 && t.style.Color.IndexColumn != nil {
+			return t.style.Color.IndexColumn
+		} else if hint.rowNumber%2 == 0 && t.style.Color.RowAlternate != nil {
+			return t.style.Color.RowAlternate
+		}
+		return t.style.Color.Row
+	}
+	return nil
+}
+
 func (t *Table) getColumnColors(colIdx int, hint renderHint) text.Colors {
 	if hint.isBorderOrSeparator() {
 		if colors := t.getColumnColorsForBorderOrSeparator(hint); colors != nil {
 			return colors
 		}
 	}
+	if cgRow := t.getColumnGroupRow(hint); cgRow != nil && !hint.isSeparatorRow {
+		return cgRow.getColors(colIdx)
+	}
+	if hint.isRegularNonSeparatorRow() && t.highlightedCells != nil {
+		if colors, ok := t.highlightedCells[cellPosition{rowIdx: hint.rowNumber - 1, colIdx: colIdx}]; ok {
+			return colors
+		}
+	}
 	if t.hasRowPainter() && hint.isRegularNonSeparatorRow() && !t.isIndexColumn(colIdx, hint) {
 		if colors := t.rowsColors[hint.rowNumber-1]; colors != nil {
 			return colors
@@ -639,6 +1175,13 @@ func (t *Table) getColumnWidthMin(colIdx int) int {
 	return 0
 }
 
+func (t *Table) getColumnTransformerWithContext(colIdx int, hint renderHint) text.ContextTransformer {
+	if cfg, ok := t.columnConfigMap[colIdx]; ok && hint.isRegularRow() {
+		return cfg.TransformerWithContext
+	}
+	return nil
+}
+
 func (t *Table) getFormat(hint renderHint) text.Format {
 	if hint.isSeparatorRow {
 		return text.FormatDefault
@@ -650,6 +1193,33 @@ func (t *Table) getFormat(hint renderHint) text.Format {
 	return t.style.Format.Row
 }
 
+// getTransformContext returns the context of the value in the given column of
+// the row for a text.ContextTransformer.
+func (t *Table) getTransformContext(row Row, colIdx int, hint renderHint) text.TransformContext {
+	ctx := text.TransformContext{
+		Value:     row[colIdx],
+		Row:       row,
+		RowNumber: hint.rowNumber,
+		Column:    colIdx + 1,
+		Columns:   make(map[string]interface{}, len(row)),
+	}
+	var header Row
+	if rowsHeaderRaw := t.initForRenderRowsHeaderRaw(); len(rowsHeaderRaw) > 0 {
+		header = rowsHeaderRaw[0]
+	}
+	for idx, val := range row {
+		name := AutoIndexColumnID(idx)
+		if idx < len(header) {
+			name = fmt.Sprint(header[idx])
+		}
+		ctx.Columns[name] = val
+		if idx == colIdx {
+			ctx.ColumnName = name
+		}
+	}
+	return ctx
+}
+
 func (t *Table) getMaxColumnLengthForMerging(colIdx int) int {
 	maxColumnLength := t.maxColumnLengths[colIdx]
 	maxColumnLength += text.StringWidthWithoutEscSequences(t.style.Box.PaddingRight + t.style.Box.PaddingLeft)
@@ -662,6 +1232,9 @@ func (t *Table) getMaxColumnLengthForMerging(colIdx int) int {
 // getMergedColumnIndices returns a map of colIdx values to all the other colIdx
 // values (that are being merged) and their lengths.
 func (t *Table) getMergedColumnIndices(row rowStr, hint renderHint) mergedColumnIndices {
+	if cgRow := t.getColumnGroupRow(hint); cgRow != nil {
+		return cgRow.spans
+	}
 	if !t.getRowConfig(hint).AutoMerge {
 		return nil
 	}
@@ -712,6 +1285,11 @@ func (t *Table) getRowConfig(hint renderHint) RowConfig {
 
 	switch {
 	case hint.isHeaderRow:
+		// the column group rows precede the header rows appended by the user
+		rowIdx -= len(t.columnGroupRows)
+		if rowIdx < 0 {
+			return RowConfig{}
+		}
 		return t.rowsHeaderConfigMap[rowIdx]
 	case hint.isFooterRow:
 		return t.rowsFooterConfigMap[rowIdx]
@@ -720,6 +1298,19 @@ func (t *Table) getRowConfig(hint renderHint) RowConfig {
 	}
 }
 
+// getRowLength returns the length of the rows spanning the full width of the
+// table (title, sections and notes) after applying the size limits.
+func (t *Table) getRowLength() int {
+	rowLength := t.maxRowLength
+	if wm := t.style.Size.WidthMax; wm > 0 && wm < rowLength {
+		rowLength = wm
+	}
+	if wm := t.style.Size.WidthMin; wm > 0 && wm > rowLength {
+		rowLength = wm
+	}
+	return rowLength
+}
+
 func (t *Table) getSeparatorColors(hint renderHint) text.Colors {
 	if t.style.Options.DoNotColorBordersAndSeparators {
 		return nil
@@ -816,17 +1407,21 @@ func (t *Table) isIndexColumn(colIdx int, hint renderHint) bool {
 // the output builder and avoid repeated re-allocations while rendering.
 func (t *Table) estimatedRenderLength() int {
 	numRows := len(t.rows) + len(t.rowsHeader) + len(t.rowsFooter) + 1
-	return numRows * (t.maxRowLength + 1)
+	if estimate := numRows * (t.maxRowLength + 1); t.renderTarget == nil || estimate < renderToChunkSize {
+		return estimate
+	}
+	// leave room for a chunk and the row that overflows it
+	return renderToChunkSize + t.maxRowLength + 1
 }
 
 func (t *Table) render(out *strings.Builder) string {
-	outStr := out.String()
-	if t.suppressTrailingSpaces {
-		var trimmed []string
-		for _, line := range strings.Split(outStr, "\n") {
-			trimmed = append(trimmed, strings.TrimRightFunc(line, unicode.IsSpace))
+	outStr := t.finalizeOutput(out.String())
+	if t.renderTarget != nil {
+		t.renderTarget.write(outStr)
+		if t.renderTarget.written > 0 {
+			t.renderTarget.write("\n")
 		}
-		outStr = strings.Join(trimmed, "\n")
+		return ""
 	}
 	if t.outputMirror != nil && len(outStr) > 0 {
 		_, _ = t.outputMirror.Write([]byte(outStr))
@@ -835,18 +1430,41 @@ func (t *Table) render(out *strings.Builder) string {
 	return outStr
 }
 
+// finalizeOutput trims the trailing spaces from the rendered output, and
+// converts the RGB colors in it to the ColorDepth supported by the terminal
+// unless it is HTML (which uses the exact colors).
+func (t *Table) finalizeOutput(outStr string) string {
+	outStr = t.trimTrailingSpaces(outStr)
+	if t.renderMode != renderModeHTML {
+		outStr = text.DegradeRGBColors(outStr)
+	}
+	return outStr
+}
+
+// trimTrailingSpaces removes the trailing spaces from every line if directed
+// to by SuppressTrailingSpaces().
+func (t *Table) trimTrailingSpaces(outStr string) string {
+	if !t.suppressTrailingSpaces {
+		return outStr
+	}
+	var trimmed []string
+	for _, line := range strings.Split(outStr, "\n") {
+		trimmed = append(trimmed, strings.TrimRightFunc(line, unicode.IsSpace))
+	}
+	return strings.Join(trimmed, "\n")
+}
+
 func (t *Table) shouldMergeCellsHorizontallyAbove(row rowStr, colIdx int, hint renderHint) bool {
 	if hint.isAutoIndexColumn || hint.isAutoIndexRow {
 		return false
 	}
 
-	rowConfig := t.getRowConfig(hint)
+	rowHint := hint
 	if hint.isSeparatorRow {
 		if hint.isHeaderRow && hint.rowNumber == 1 {
-			rowConfig = t.getRowConfig(hint)
 			row = t.getRow(hint.rowNumber-1, hint)
 		} else if hint.isFooterRow && hint.isFirstRow {
-			rowConfig = t.getRowConfig(renderHint{isLastRow: true, rowNumber: len(t.rows)})
+			rowHint = renderHint{isLastRow: true, rowNumber: len(t.rows)}
 			row = t.getRow(len(t.rows)-1, renderHint{})
 		} else if hint.isFooterRow && hint.isBorderBottom {
 			row = t.getRow(len(t.rowsFooter)-1, renderHint{isFooterRow: true})
@@ -854,11 +1472,7 @@ func (t *Table) shouldMergeCellsHorizontallyAbove(row rowStr, colIdx int, hint r
 			row = t.getRow(hint.rowNumber-1, hint)
 		}
 	}
-
-	if rowConfig.AutoMerge {
-		return row.areEqual(colIdx-1, colIdx)
-	}
-	return false
+	return t.shouldMergeCellsHorizontally(row, colIdx, rowHint)
 }
 
 func (t *Table) shouldMergeCellsHorizontallyBelow(row rowStr, colIdx int, hint renderHint) bool {
@@ -866,27 +1480,41 @@ func (t *Table) shouldMergeCellsHorizontallyBelow(row rowStr, colIdx int, hint r
 		return false
 	}
 
-	var rowConfig RowConfig
-	if hint.isSeparatorRow {
-		if hint.isRegularRow() {
-			rowConfig = t.getRowConfig(renderHint{rowNumber: hint.rowNumber + 1})
-			row = t.getRow(hint.rowNumber, renderHint{})
-		} else if hint.isHeaderRow && hint.rowNumber == 0 {
-			rowConfig = t.getRowConfig(renderHint{isHeaderRow: true, rowNumber: 1})
-			row = t.getRow(0, hint)
-		} else if hint.isHeaderRow && hint.isLastRow {
-			rowConfig = t.getRowConfig(renderHint{rowNumber: 1})
-			row = t.getRow(0, renderHint{})
-		} else if hint.isHeaderRow {
-			rowConfig = t.getRowConfig(renderHint{isHeaderRow: true, rowNumber: hint.rowNumber + 1})
-			row = t.getRow(hint.rowNumber, hint)
-		} else if hint.isFooterRow && hint.rowNumber >= 0 {
-			rowConfig = t.getRowConfig(renderHint{isFooterRow: true, rowNumber: 1})
-			row = t.getRow(hint.rowNumber, renderHint{isFooterRow: true})
-		}
+	if !hint.isSeparatorRow {
+		return false
+	}
+
+	var rowHint renderHint
+	if hint.isRegularRow() {
+		rowHint = renderHint{rowNumber: hint.rowNumber + 1}
+		row = t.getRow(hint.rowNumber, renderHint{})
+	} else if hint.isHeaderRow && hint.rowNumber == 0 {
+		rowHint = renderHint{isHeaderRow: true, rowNumber: 1}
+		row = t.getRow(0, hint)
+	} else if hint.isHeaderRow && hint.isLastRow {
+		rowHint = renderHint{rowNumber: 1}
+		row = t.getRow(0, renderHint{})
+	} else if hint.isHeaderRow {
+		rowHint = renderHint{isHeaderRow: true, rowNumber: hint.rowNumber + 1}
+		row = t.getRow(hint.rowNumber, hint)
+	} else if hint.isFooterRow && hint.rowNumber >= 0 {
+		rowHint = renderHint{isFooterRow: true, rowNumber: 1}
+		row = t.getRow(hint.rowNumber, renderHint{isFooterRow: true})
+	} else {
+		return false
 	}
+	return t.shouldMergeCellsHorizontally(row, colIdx, rowHint)
+}
 
-	if rowConfig.AutoMerge {
+// shouldMergeCellsHorizontally returns true if the cell in the given column is
+// to be merged with the one before it in the row identified by the hint; this
+// is the case for cells in the same column group, or for equal cells in rows
+// with RowConfig.AutoMerge.
+func (t *Table) shouldMergeCellsHorizontally(row rowStr, colIdx int, hint renderHint) bool {
+	if cgRow := t.getColumnGroupRow(hint); cgRow != nil {
+		return cgRow.isSpanned(colIdx-1, colIdx)
+	}
+	if t.getRowConfig(hint).AutoMerge {
 		return row.areEqual(colIdx-1, colIdx)
 	}
 	return false
@@ -930,7 +1558,7 @@ func (t *Table) shouldMergeCellsVerticallyBelow(colIdx int, hint renderHint) int
 
 func (t *Table) shouldSeparateRows(rowIdx int, numRows int) bool {
 	// not asked to separate rows and no manually added separator
-	if !t.style.Options.SeparateRows && !t.separators[rowIdx] {
+	if !t.style.Options.SeparateRows && !t.separatorsRendered[rowIdx] {
 		return false
 	}
 
@@ -947,6 +1575,14 @@ func (t *Table) shouldSeparateRows(rowIdx int, numRows int) bool {
 	return true
 }
 
+// shouldSeparateColumnGroupRow returns true if a separator is to be rendered
+// below the given header row, which is the case for the column group rows when
+// the header is being separated from the rest of the table.
+func (t *Table) shouldSeparateColumnGroupRow(rowIdx int, numRows int, hint renderHint) bool {
+	return hint.isHeaderRow && t.style.Options.SeparateHeader &&
+		rowIdx < len(t.columnGroupRows) && rowIdx < numRows-1
+}
+
 // wrapCell fits a single column's value into its width limit (WidthMax, or the
 // column's longest line when no limit is set) using the column's enforcer.
 func (t *Table) wrapCell(colIdx int, colStr string) string {
@@ -994,7 +1630,7 @@ func (t *Table) shouldInterleaveVerticalMerge(hint renderHint) bool {
 	if hint.isHeaderRow || hint.isFooterRow {
 		return false
 	}
-	if t.style.Options.SeparateRows || len(t.separators) > 0 {
+	if t.style.Options.SeparateRows || len(t.separatorsRendered) > 0 {
 		return false
 	}
 	if t.autoIndex || t.pager.size > 0 {
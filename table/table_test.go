@@ -38,6 +38,7 @@ var (
 
 func init() {
 	text.EnableColors()
+	text.SetColorDepthRGB(text.ColorDepthTrueColor)
 }
 
 type myMockOutputMirror struct {
@@ -152,6 +153,80 @@ func TestTable_AppendRows(t *testing.T) {
 	assert.True(t, table.rowsConfigMap[3].AutoMerge)
 }
 
+func TestTable_DeleteRow(t *testing.T) {
+	table := Table{}
+	table.AppendRow(testRows[0])
+	table.AppendRow(testRows[1], RowConfig{AutoMerge: true})
+	table.AppendSeparator()
+	table.AppendRow(testRows[2], RowConfig{AutoMergeAlign: text.AlignRight})
+
+	assert.False(t, table.DeleteRow(-1))
+	assert.False(t, table.DeleteRow(3))
+
+	assert.True(t, table.DeleteRow(0))
+	assert.Equal(t, 2, table.Length())
+	assert.Equal(t, []Row{testRows[1], testRows[2]}, table.Rows())
+	assert.True(t, table.rowsConfigMap[0].AutoMerge)
+	assert.Equal(t, text.AlignRight, table.rowsConfigMap[1].AutoMergeAlign)
+	assert.Equal(t, map[int]bool{0: true}, table.separators)
+
+	table.AppendRow(testRows[0])
+	assert.True(t, table.DeleteRow(0))
+	assert.Equal(t, []Row{testRows[2], testRows[0]}, table.Rows())
+	assert.Equal(t, map[int]RowConfig{0: {AutoMergeAlign: text.AlignRight}}, table.rowsConfigMap)
+	assert.Empty(t, table.separators)
+}
+
+func TestTable_FindRow(t *testing.T) {
+	table := Table{}
+	table.AppendRows(testRows)
+
+	idx, row := table.FindRow(1, 20)
+	assert.Equal(t, 1, idx)
+	assert.Equal(t, testRows[1], row)
+	row[1] = "Aegon"
+	assert.Equal(t, "Jon", table.Rows()[1][1])
+
+	idx, row = table.FindRow(2, "Tyrion")
+	assert.Equal(t, 2, idx)
+	assert.Equal(t, testRows[2], row)
+
+	idx, row = table.FindRow(1, int64(20))
+	assert.Equal(t, -1, idx)
+	assert.Nil(t, row)
+	idx, row = table.FindRow(0, 20)
+	assert.Equal(t, -1, idx)
+	assert.Nil(t, row)
+	idx, row = table.FindRow(5, "You know nothing, Jon Snow!")
+	assert.Equal(t, 1, idx)
+	assert.Equal(t, testRows[1], row)
+}
+
+func TestTable_FindRowByName(t *testing.T) {
+	table := Table{}
+	table.AppendRows(testRows)
+
+	// without a header, the columns are named A, B, C, etc.
+	idx, row := table.FindRowByName("B", "Tyrion")
+	assert.Equal(t, 2, idx)
+	assert.Equal(t, testRows[2], row)
+
+	table.AppendHeader(testHeader)
+	idx, row = table.FindRowByName("First Name", "Tyrion")
+	assert.Equal(t, 2, idx)
+	assert.Equal(t, testRows[2], row)
+	idx, row = table.FindRowByName("#", 20)
+	assert.Equal(t, 1, idx)
+	assert.Equal(t, testRows[1], row)
+
+	idx, row = table.FindRowByName("B", "Tyrion")
+	assert.Equal(t, -1, idx)
+	assert.Nil(t, row)
+	idx, row = table.FindRowByName("First Name", "Sansa")
+	assert.Equal(t, -1, idx)
+	assert.Nil(t, row)
+}
+
 func TestTable_ImportGrid(t *testing.T) {
 	t.Run("invalid grid", func(t *testing.T) {
 		table := Table{}
@@ -273,6 +348,39 @@ func TestTable_ImportGrid(t *testing.T) {
 	})
 }
 
+func TestTable_InsertRow(t *testing.T) {
+	table := Table{}
+	table.AppendRow(testRows[0], RowConfig{AutoMerge: true})
+	table.AppendSeparator()
+	table.AppendRow(testRows[2])
+
+	assert.False(t, table.InsertRow(-1, testRows[1]))
+	assert.False(t, table.InsertRow(3, testRows[1]))
+
+	assert.True(t, table.InsertRow(1, testRows[1], RowConfig{AutoMergeAlign: text.AlignRight}))
+	assert.Equal(t, testRows, table.Rows())
+	assert.True(t, table.rowsConfigMap[0].AutoMerge)
+	assert.Equal(t, text.AlignRight, table.rowsConfigMap[1].AutoMergeAlign)
+	assert.Equal(t, map[int]bool{0: true}, table.separators)
+
+	assert.True(t, table.InsertRow(0, testRows[2]))
+	assert.True(t, table.InsertRow(4, testRows[0]))
+	assert.Equal(t, []Row{testRows[2], testRows[0], testRows[1], testRows[2], testRows[0]}, table.Rows())
+	assert.True(t, table.rowsConfigMap[1].AutoMerge)
+	assert.Equal(t, text.AlignRight, table.rowsConfigMap[2].AutoMergeAlign)
+	assert.Equal(t, map[int]bool{1: true}, table.separators)
+
+	table.SetStyle(StyleDefault)
+	table.FilterBy([]FilterBy{{Number: 1, Operator: LessThan, Value: 300}})
+	compareOutput(t, table.Render(), `
++----+------+-------+------+-----------------------------+
+|  1 | Arya | Stark | 3000 |                             |
++----+------+-------+------+-----------------------------+
+| 20 | Jon  | Snow  | 2000 | You know nothing, Jon Snow! |
+|  1 | Arya | Stark | 3000 |                             |
++----+------+-------+------+-----------------------------+`)
+}
+
 func TestTable_Length(t *testing.T) {
 	table := Table{}
 	assert.Zero(t, table.Length())
@@ -313,6 +421,18 @@ func TestTable_ResetRows(t *testing.T) {
 	assert.Empty(t, table.rowsRawFiltered)
 }
 
+func TestTable_Rows(t *testing.T) {
+	table := Table{}
+	assert.Empty(t, table.Rows())
+
+	table.AppendRows(testRows)
+	rows := table.Rows()
+	assert.Equal(t, testRows, rows)
+
+	rows[0][1] = "Sansa"
+	assert.Equal(t, "Arya", table.Rows()[0][1])
+}
+
 func TestTable_SetAllowedRowLength(t *testing.T) {
 	table := Table{}
 	table.AppendRows(testRows)
@@ -498,3 +618,67 @@ func TestTable_SetStyle(t *testing.T) {
 	assert.NotNil(t, table.Style())
 	assert.Equal(t, StyleDefault, *table.Style())
 }
+
+func TestTable_UpdateRow(t *testing.T) {
+	table := Table{}
+	table.AppendRow(testRows[0], RowConfig{AutoMerge: true})
+	table.AppendSeparator()
+	table.AppendRow(testRows[1])
+
+	assert.False(t, table.UpdateRow(-1, testRows[2]))
+	assert.False(t, table.UpdateRow(2, testRows[2]))
+
+	assert.True(t, table.UpdateRow(0, testRows[2]))
+	assert.Equal(t, []Row{testRows[2], testRows[1]}, table.Rows())
+	assert.True(t, table.rowsConfigMap[0].AutoMerge)
+	assert.Equal(t, map[int]bool{0: true}, table.separators)
+
+	assert.True(t, table.UpdateRow(1, testRows[0], RowConfig{AutoMergeAlign: text.AlignRight}))
+	assert.Equal(t, []Row{testRows[2], testRows[0]}, table.Rows())
+	assert.Equal(t, text.AlignRight, table.rowsConfigMap[1].AutoMergeAlign)
+
+	table.SetStyle(StyleDefault)
+	compareOutput(t, table.Render(), `
++-----+--------+-----------+------+
+| 300 | Tyrion | Lannister | 5000 |
++-----+--------+-----------+------+
+|   1 | Arya   | Stark     | 3000 |
++-----+--------+-----------+------+`)
+}
+
+func TestTable_UpsertRow(t *testing.T) {
+	table := Table{}
+	table.AppendRows(testRows)
+
+	assert.Equal(t, -1, table.UpsertRow(0, Row{1, "Sansa"}))
+	assert.Equal(t, -1, table.UpsertRow(6, Row{1, "Sansa"}))
+
+	assert.Equal(t, 0, table.UpsertRow(1, Row{1, "Sansa", "Stark", 4000}))
+	assert.Equal(t, 3, table.UpsertRow(1, Row{4000, "Bran", "Stark", 1000}, RowConfig{AutoMerge: true}))
+	assert.Equal(t, 3, table.UpsertRow(2, Row{4000, "Bran", "Stark", 6000}))
+	assert.Equal(t, 4, table.Length())
+	assert.Equal(t, []Row{
+		{1, "Sansa", "Stark", 4000},
+		testRows[1],
+		testRows[2],
+		{4000, "Bran", "Stark", 6000},
+	}, table.Rows())
+	assert.True(t, table.rowsConfigMap[3].AutoMerge)
+}
+
+func TestTable_UpsertRowByName(t *testing.T) {
+	table := Table{}
+	assert.Equal(t, 0, table.UpsertRowByName("A", Row{1, "Arya", "Stark", 3000}))
+	assert.Equal(t, 0, table.UpsertRowByName("A", Row{1, "Sansa", "Stark", 4000}))
+
+	table.AppendHeader(testHeader)
+	assert.Equal(t, -1, table.UpsertRowByName("A", Row{1, "Arya"}))
+	assert.Equal(t, -1, table.UpsertRowByName("Salary", Row{1, "Arya"}))
+	assert.Equal(t, 1, table.UpsertRowByName("First Name", Row{2, "Bran", "Stark", 1000}, RowConfig{AutoMerge: true}))
+	assert.Equal(t, 1, table.UpsertRowByName("#", Row{2, "Bran", "Stark", 6000}))
+	assert.Equal(t, []Row{
+		{1, "Sansa", "Stark", 4000},
+		{2, "Bran", "Stark", 6000},
+	}, table.Rows())
+	assert.True(t, table.rowsConfigMap[1].AutoMerge)
+}
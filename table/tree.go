@@ -0,0 +1,171 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/list"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// getRowIndexRaw returns the index in rowsRaw of the given row in
+// rowsRawFiltered.
+func (t *Table) getRowIndexRaw(rowIdx int) int {
+	if t.rowsRawFilteredIndices != nil {
+		return t.rowsRawFilteredIndices[rowIdx]
+	}
+	return rowIdx
+}
+
+// getTreeColumnIndex returns the index of the column to draw the tree
+// connectors in.
+func (t *Table) getTreeColumnIndex() int {
+	for colIdx, cfg := range t.columnConfigMap {
+		if cfg.TreeColumn {
+			return colIdx
+		}
+	}
+	return 0
+}
+
+// initForRenderTreeRows arranges the rows (given in the sorted order, or nil
+// to retain the order in which they were appended) such that the child rows
+// follow their parents, and adds the tree connectors to the tree column.
+// Returns the indices of the rows to be rendered in order; the rows under the
+// collapsed ones, and the ones beyond Style().Tree.MaxDepth are left out.
+func (t *Table) initForRenderTreeRows(sortedRowIndices []int) []int {
+	if sortedRowIndices == nil {
+		sortedRowIndices = make([]int, len(t.rows))
+		for idx := range sortedRowIndices {
+			sortedRowIndices[idx] = idx
+		}
+	}
+
+	// map the parents from the raw rows to the filtered rows, and find the
+	// children of every row in the sorted order
+	rowIdxByRawIdx := make(map[int]int, len(t.rows))
+	for rowIdx := range t.rows {
+		rowIdxByRawIdx[t.getRowIndexRaw(rowIdx)] = rowIdx
+	}
+	var roots []int
+	children := make(map[int][]int)
+	for _, rowIdx := range sortedRowIndices {
+		parentIdxRaw, hasParent := t.rowsParentMap[t.getRowIndexRaw(rowIdx)]
+		if parentIdx, ok := rowIdxByRawIdx[parentIdxRaw]; hasParent && ok {
+			children[parentIdx] = append(children[parentIdx], rowIdx)
+		} else {
+			roots = append(roots, rowIdx)
+		}
+	}
+
+	tree := treeRows{
+		children:   children,
+		colIdx:     t.getTreeColumnIndex(),
+		connectors: t.style.Tree.getConnectors(),
+		maxDepth:   t.style.Tree.MaxDepth,
+		rows:       t.rows,
+		table:      t,
+	}
+	for idx, rowIdx := range roots {
+		tree.add(rowIdx, 1, "", idx == len(roots)-1)
+	}
+	return tree.order
+}
+
+// matchTreeAncestors marks the ancestors of the matching child rows as
+// matching as well; the indices are the ones in rowsRaw.
+func (t *Table) matchTreeAncestors(matches []bool) {
+	if len(t.rowsParentMap) == 0 {
+		return
+	}
+	for rowIdx := range matches {
+		if !matches[rowIdx] {
+			continue
+		}
+		parentIdx, ok := t.rowsParentMap[rowIdx]
+		for ok && parentIdx < len(matches) && !matches[parentIdx] {
+			matches[parentIdx] = true
+			parentIdx, ok = t.rowsParentMap[parentIdx]
+		}
+	}
+}
+
+// treeRows arranges the rows as a tree for rendering.
+type treeRows struct {
+	children   map[int][]int
+	colIdx     int
+	connectors list.Style
+	maxDepth   int
+	order      []int
+	rows       []rowStr
+	table      *Table
+}
+
+// add adds the row, and all its children (unless collapsed) to the order of
+// rows to render, prefixing the tree column with the connectors.
+func (tr *treeRows) add(rowIdx int, depth int, prefix string, isLast bool) {
+	tr.order = append(tr.order, rowIdx)
+
+	childPrefix := prefix
+	if depth > 1 {
+		if isLast {
+			childPrefix += strings.Repeat(" ", text.StringWidthWithoutEscSequences(tr.connectors.CharItemVertical))
+		} else {
+			childPrefix += tr.connectors.CharItemVertical
+		}
+	}
+
+	children := tr.children[rowIdx]
+	collapsed := tr.table.rowsConfigMap[tr.table.getRowIndexRaw(rowIdx)].Collapsed ||
+		(tr.maxDepth > 0 && depth >= tr.maxDepth)
+	tr.decorate(rowIdx, depth, prefix, childPrefix, isLast, collapsed)
+	if collapsed {
+		return
+	}
+	for idx, childIdx := range children {
+		tr.add(childIdx, depth+1, childPrefix, idx == len(children)-1)
+	}
+}
+
+// countDescendants returns the number of rows under the given row.
+func (tr *treeRows) countDescendants(rowIdx int) int {
+	count := 0
+	for _, childIdx := range tr.children[rowIdx] {
+		count += 1 + tr.countDescendants(childIdx)
+	}
+	return count
+}
+
+// decorate adds the connectors to the tree column in the row, along with the
+// number of rows hidden under it if collapsed.
+func (tr *treeRows) decorate(rowIdx int, depth int, prefix string, childPrefix string, isLast bool, collapsed bool) {
+	row := tr.rows[rowIdx]
+	if tr.colIdx >= len(row) {
+		return
+	}
+
+	colStr := row[tr.colIdx]
+	if collapsed {
+		if numHidden := tr.countDescendants(rowIdx); numHidden == 1 {
+			colStr += " (+1 child)"
+		} else if numHidden > 1 {
+			colStr += fmt.Sprintf(" (+%d children)", numHidden)
+		}
+	}
+	if depth > 1 {
+		connector := tr.connectors.CharItemMiddle
+		if isLast {
+			connector = tr.connectors.CharItemBottom
+		}
+		lines := strings.Split(colStr, "\n")
+		for idx := range lines {
+			if idx == 0 {
+				lines[idx] = prefix + connector + " " + lines[idx]
+			} else {
+				lines[idx] = childPrefix + lines[idx]
+			}
+		}
+		colStr = strings.Join(lines, "\n")
+	}
+	row[tr.colIdx] = colStr
+}
@@ -0,0 +1,189 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTableWithChildRows() (Writer, int, int) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Module", "Cost"})
+	tw.AppendRow(Row{"app", 100})
+	web := tw.AppendChildRow(0, Row{"web", 60})
+	tw.AppendChildRow(web, Row{"nginx", 20})
+	tw.AppendChildRow(web, Row{"assets\nstatic", 40})
+	db := tw.AppendChildRow(0, Row{"db", 40})
+	tw.AppendChildRow(db, Row{"postgres", 40})
+	tw.AppendRow(Row{"infra", 50})
+	tw.SetStyle(StyleLight)
+	return tw, web, db
+}
+
+func TestTable_AppendChildRow(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		tw, _, _ := generateTableWithChildRows()
+
+		compareOutput(t, tw.Render(), `
+┌────────────────┬──────┐
+│ MODULE         │ COST │
+├────────────────┼──────┤
+│ app            │  100 │
+│ ├─ web         │   60 │
+│ │  ├─ nginx    │   20 │
+│ │  └─ assets   │   40 │
+│ │     static   │      │
+│ └─ db          │   40 │
+│    └─ postgres │   40 │
+│ infra          │   50 │
+└────────────────┴──────┘`)
+		assert.Equal(t, 7, tw.Length())
+	})
+
+	t.Run("invalid parent", func(t *testing.T) {
+		tw := NewWriter()
+		assert.Equal(t, -1, tw.AppendChildRow(0, Row{"orphan"}))
+		tw.AppendRow(Row{"root"})
+		assert.Equal(t, -1, tw.AppendChildRow(1, Row{"orphan"}))
+		assert.Equal(t, -1, tw.AppendChildRow(-1, Row{"orphan"}))
+		assert.Equal(t, 1, tw.AppendChildRow(0, Row{"child"}))
+		assert.Equal(t, 2, tw.Length())
+	})
+
+	t.Run("tree column", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"#", "Name"})
+		tw.AppendRow(Row{1, "root"})
+		tw.AppendChildRow(0, Row{2, "child"})
+		tw.SetColumnConfigs([]ColumnConfig{{Name: "Name", TreeColumn: true}})
+		tw.SetStyle(StyleLight)
+		tw.Style().Tree.Connectors = list.StyleConnectedRounded
+
+		compareOutput(t, tw.Render(), `
+┌───┬──────────┐
+│ # │ NAME     │
+├───┼──────────┤
+│ 1 │ root     │
+│ 2 │ ╰─ child │
+└───┴──────────┘`)
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+		tw, _, _ := generateTableWithChildRows()
+		tw.SortBy([]SortBy{{Name: "Module", Mode: Asc}})
+
+		compareOutput(t, tw.Render(), `
+┌────────────────┬──────┐
+│ MODULE         │ COST │
+├────────────────┼──────┤
+│ app            │  100 │
+│ ├─ db          │   40 │
+│ │  └─ postgres │   40 │
+│ └─ web         │   60 │
+│    ├─ assets   │   40 │
+│    │  static   │      │
+│    └─ nginx    │   20 │
+│ infra          │   50 │
+└────────────────┴──────┘`)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		tw, _, _ := generateTableWithChildRows()
+		tw.FilterBy([]FilterBy{{Name: "Module", Operator: Contains, Value: "ngin"}})
+
+		compareOutput(t, tw.Render(), `
+┌─────────────┬──────┐
+│ MODULE      │ COST │
+├─────────────┼──────┤
+│ app         │  100 │
+│ └─ web      │   60 │
+│    └─ nginx │   20 │
+└─────────────┴──────┘`)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		tw, _, _ := generateTableWithChildRows()
+		tw.Style().Tree.MaxDepth = 2
+
+		compareOutput(t, tw.Render(), `
+┌──────────────────────┬──────┐
+│ MODULE               │ COST │
+├──────────────────────┼──────┤
+│ app                  │  100 │
+│ ├─ web (+2 children) │   60 │
+│ └─ db (+1 child)     │   40 │
+│ infra                │   50 │
+└──────────────────────┴──────┘`)
+	})
+
+	t.Run("collapsed", func(t *testing.T) {
+		tw, _, _ := generateTableWithChildRows()
+		tw.UpdateRow(0, Row{"app", 100}, RowConfig{Collapsed: true})
+
+		compareOutput(t, tw.Render(), `
+┌───────────────────┬──────┐
+│ MODULE            │ COST │
+├───────────────────┼──────┤
+│ app (+5 children) │  100 │
+│ infra             │   50 │
+└───────────────────┴──────┘`)
+	})
+
+	t.Run("insert and delete", func(t *testing.T) {
+		tw, web, _ := generateTableWithChildRows()
+		assert.True(t, tw.InsertRow(0, Row{"docs", 10}))
+		assert.True(t, tw.DeleteRow(web+1))
+		assert.Equal(t, 7, tw.AppendChildRow(0, Row{"readme", 5}))
+
+		compareOutput(t, tw.Render(), `
+┌────────────────┬──────┐
+│ MODULE         │ COST │
+├────────────────┼──────┤
+│ docs           │   10 │
+│ └─ readme      │    5 │
+│ app            │  100 │
+│ ├─ nginx       │   20 │
+│ ├─ assets      │   40 │
+│ │  static      │      │
+│ └─ db          │   40 │
+│    └─ postgres │   40 │
+│ infra          │   50 │
+└────────────────┴──────┘`)
+
+		assert.True(t, tw.DeleteRow(1))
+		compareOutput(t, tw.Render(), `
+┌─────────────┬──────┐
+│ MODULE      │ COST │
+├─────────────┼──────┤
+│ docs        │   10 │
+│ └─ readme   │    5 │
+│ nginx       │   20 │
+│ assets      │   40 │
+│ static      │      │
+│ db          │   40 │
+│ └─ postgres │   40 │
+│ infra       │   50 │
+└─────────────┴──────┘`)
+	})
+
+	t.Run("clone and reset", func(t *testing.T) {
+		tw, _, _ := generateTableWithChildRows()
+		clone := tw.(*Table).Clone()
+		tw.ResetRows()
+		tw.AppendRow(Row{"new", 1})
+
+		assert.Contains(t, clone.Render(), "│ └─ db          │   40 │")
+		compareOutput(t, tw.Render(), `
+┌────────┬──────┐
+│ MODULE │ COST │
+├────────┼──────┤
+│ new    │    1 │
+└────────┴──────┘`)
+	})
+}
+
+func TestTreeOptions_getConnectors(t *testing.T) {
+	assert.Equal(t, list.StyleConnectedLight, TreeOptions{}.getConnectors())
+	assert.Equal(t, list.StyleConnectedBold, TreeOptions{Connectors: list.StyleConnectedBold}.getConnectors())
+}
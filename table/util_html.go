@@ -1,6 +1,8 @@
 package table
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -12,11 +14,20 @@ func convertEscSequencesToSpans(str string) string {
 	return converter.Convert(str)
 }
 
+// convertEscSequencesToInlineStyles converts ANSI escape sequences to HTML
+// <span> tags with inline CSS styles (refer to htmlInlineStyle).
+func convertEscSequencesToInlineStyles(str string) string {
+	converter := newEscSeqToSpanConverter()
+	converter.inlineStyles = true
+	return converter.Convert(str)
+}
+
 // escSeqToSpanConverter converts ANSI escape sequences to HTML <span> tags with CSS classes.
 type escSeqToSpanConverter struct {
 	result        strings.Builder
 	esp           text.EscSeqParser
 	currentColors map[int]bool
+	inlineStyles  bool
 }
 
 // newEscSeqToSpanConverter creates a new escape sequence to span converter.
@@ -76,24 +87,47 @@ func (c *escSeqToSpanConverter) closeSpan() {
 
 // colorsChanged checks if the color set has changed.
 func (c *escSeqToSpanConverter) colorsChanged(newColors map[int]bool) bool {
-	// we never set the map values to false, so a simple size compare is enough
-	return len(c.currentColors) != len(newColors)
+	// we never set the map values to false, so comparing the keys is enough
+	if len(c.currentColors) != len(newColors) {
+		return true
+	}
+	for code := range newColors {
+		if !c.currentColors[code] {
+			return true
+		}
+	}
+	return false
 }
 
-// cssClasses converts color codes to CSS class names.
-func (c *escSeqToSpanConverter) cssClasses(codes map[int]bool) string {
+// cssClassesAndStyle converts color codes to CSS class names, and the inline
+// CSS style for the RGB colors (or for all the colors with inlineStyles).
+func (c *escSeqToSpanConverter) cssClassesAndStyle(codes map[int]bool) (string, string) {
 	var colors text.Colors
 	for code := range codes {
 		colors = append(colors, text.Color(code))
 	}
-	return colors.CSSClasses()
+	if c.inlineStyles {
+		sort.Slice(colors, func(i, j int) bool { return colors[i] < colors[j] })
+		return "", htmlInlineStyle(colors)
+	}
+	return colors.CSSClasses(), colors.CSSStyle()
 }
 
-// openSpan opens a new span with the given CSS class and tracks the colors.
-func (c *escSeqToSpanConverter) openSpan(class string, newColors map[int]bool) {
-	c.result.WriteString("<span class=\"")
-	c.result.WriteString(class)
-	c.result.WriteString("\">")
+// openSpan opens a new span with the given CSS class/style and tracks the
+// colors.
+func (c *escSeqToSpanConverter) openSpan(class string, style string, newColors map[int]bool) {
+	c.result.WriteString("<span")
+	if class != "" {
+		c.result.WriteString(" class=\"")
+		c.result.WriteString(class)
+		c.result.WriteString("\"")
+	}
+	if style != "" {
+		c.result.WriteString(" style=\"")
+		c.result.WriteString(style)
+		c.result.WriteString("\"")
+	}
+	c.result.WriteString(">")
 	// Track colors since we opened a span
 	c.currentColors = make(map[int]bool)
 	for code := range newColors {
@@ -118,11 +152,11 @@ func (c *escSeqToSpanConverter) updateSpan(newColors map[int]bool) {
 
 	// Open new span if there are colors with valid CSS classes
 	if len(newColors) > 0 {
-		class := c.cssClasses(newColors)
-		if class != "" {
-			c.openSpan(class, newColors)
+		class, style := c.cssClassesAndStyle(newColors)
+		if class != "" || style != "" {
+			c.openSpan(class, style, newColors)
 		} else {
-			// No CSS classes, so don't track these colors
+			// No CSS classes/style, so don't track these colors
 			c.clearColors()
 		}
 	} else {
@@ -149,3 +183,64 @@ func (c *escSeqToSpanConverter) writeEscapedRune(char rune) {
 		c.result.WriteRune(char)
 	}
 }
+
+// htmlBasicColor returns the foreground and background variants of the basic
+// color at the index (in the order: black, red, green, yellow, blue, magenta,
+// cyan, white, and their "Hi" variants).
+func htmlBasicColor(idx int) (text.Color, text.Color) {
+	if idx >= 8 {
+		return text.FgHiBlack + text.Color(idx-8), text.BgHiBlack + text.Color(idx-8)
+	}
+	return text.FgBlack + text.Color(idx), text.BgBlack + text.Color(idx)
+}
+
+// htmlInlineDeclaration returns the CSS property and value for the color, for
+// use in a "style" attribute; the 16 basic colors use the palette of the dark
+// theme of RenderHTMLDocument, which matches the colors of a terminal.
+func htmlInlineDeclaration(color text.Color) (string, string) {
+	declaration := color.CSSStyle() // RGB colors
+	if declaration == "" {
+		for _, attribute := range htmlStyleAttributes {
+			// blinking needs the keyframes from a stylesheet
+			if attribute.color == color && color != text.BlinkSlow && color != text.BlinkRapid {
+				declaration = attribute.declaration
+			}
+		}
+	}
+	if declaration != "" {
+		declaration = strings.TrimSuffix(declaration, ";")
+		if idx := strings.Index(declaration, ": "); idx > 0 {
+			return declaration[:idx], declaration[idx+2:]
+		}
+		return "", ""
+	}
+
+	var r, g, b int
+	class := color.CSSClasses()
+	if _, err := fmt.Sscanf(class, "fg-256-%d-%d-%d", &r, &g, &b); err == nil {
+		return "color", fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	} else if _, err := fmt.Sscanf(class, "bg-256-%d-%d-%d", &r, &g, &b); err == nil {
+		return "background-color", fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	}
+	for idx, value := range htmlPaletteDark.colors {
+		if fg, bg := htmlBasicColor(idx); color == fg {
+			return "color", value
+		} else if color == bg {
+			return "background-color", value
+		}
+	}
+	return "", ""
+}
+
+// htmlInlineStyle returns the inline CSS style for the colors (ex.:
+// "color: #cd3131; font-weight: bold;"), for the e-mail clients that strip the
+// stylesheets and the classes.
+func htmlInlineStyle(colors text.Colors) string {
+	var declarations []string
+	for _, color := range colors {
+		if property, value := htmlInlineDeclaration(color); property != "" {
+			declarations = append(declarations, property+": "+value+";")
+		}
+	}
+	return strings.Join(declarations, " ")
+}
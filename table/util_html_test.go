@@ -80,6 +80,24 @@ func Test_convertEscSequencesToSpans(t *testing.T) {
 		}
 	})
 
+	t.Run("rgb colors", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    string
+			expected string
+		}{
+			{"foreground", text.FgHex("#ff8800").Sprint("Brand"), "<span style=\"color: #ff8800;\">Brand</span>"},
+			{"background with attribute", text.Colors{text.Bold, text.BgRGB(1, 2, 3)}.Sprint("Dark"), "<span class=\"bold\" style=\"background-color: #010203;\">Dark</span>"},
+			{"color changes without reset", text.FgHex("#ff0000").EscapeSeq() + "A" + text.FgHex("#00ff00").EscapeSeq() + "B" + text.Reset.EscapeSeq(), "<span style=\"color: #ff0000;\">A</span><span style=\"color: #00ff00;\">B</span>"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := convertEscSequencesToSpans(tt.input)
+				assert.Equal(t, tt.expected, result)
+			})
+		}
+	})
+
 	t.Run("edge cases", func(t *testing.T) {
 		tests := []struct {
 			name     string
@@ -99,3 +117,23 @@ func Test_convertEscSequencesToSpans(t *testing.T) {
 		}
 	})
 }
+
+func Test_convertEscSequencesToInlineStyles(t *testing.T) {
+	assert.Equal(t, "", convertEscSequencesToInlineStyles(""))
+	assert.Equal(t, "Hello &lt;World&gt;", convertEscSequencesToInlineStyles("Hello <World>"))
+	assert.Equal(t,
+		"<span style=\"font-weight: bold; color: #cd3131;\">Bold Red</span> <span style=\"background-color: #2472c8;\">Blue</span>",
+		convertEscSequencesToInlineStyles(text.Colors{text.FgRed, text.Bold}.Sprint("Bold Red")+" "+text.BgBlue.Sprint("Blue")),
+	)
+}
+
+func Test_htmlInlineStyle(t *testing.T) {
+	assert.Equal(t, "", htmlInlineStyle(nil))
+	assert.Equal(t, "color: #cd3131; background-color: #ffffff;", htmlInlineStyle(text.Colors{text.FgRed, text.BgHiWhite}))
+	assert.Equal(t, "color: #ff6600; background-color: #000000;", htmlInlineStyle(text.Colors{text.Fg256Color(208), text.Bg256Color(16)}))
+	assert.Equal(t, "color: #ff8800; background-color: #102030;", htmlInlineStyle(text.Colors{text.FgRGB(255, 136, 0), text.BgRGB(16, 32, 48)}))
+	assert.Equal(t, "font-weight: bold; font-style: italic; text-decoration: underline;",
+		htmlInlineStyle(text.Colors{text.Bold, text.Italic, text.Underline}))
+	// blinking needs a stylesheet, and resetting has nothing to render
+	assert.Equal(t, "", htmlInlineStyle(text.Colors{text.BlinkSlow, text.BlinkRapid, text.Reset}))
+}
@@ -0,0 +1,217 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Errors reported by Validate(); use errors.Is() on the error returned, or on
+// the individual errors in ValidationError.Errors to find out what went wrong.
+var (
+	// ErrConflictingColumn is reported when a config has both Name and
+	// Number set, and they refer to different columns.
+	ErrConflictingColumn = errors.New("conflicting column name and number")
+	// ErrInvalidFilterRegex is reported when the Value of a RegexMatch or
+	// RegexNotMatch filter is not a valid regular expression.
+	ErrInvalidFilterRegex = errors.New("invalid regular expression")
+	// ErrInvalidFilterValue is reported when the Value of a filter using a
+	// numeric operator (GreaterThan, LessThan, etc.) is not a number.
+	ErrInvalidFilterValue = errors.New("invalid value for numeric operator")
+	// ErrUnknownColumn is reported when a config refers to a column name not
+	// found in the header, or to a column number outside the table.
+	ErrUnknownColumn = errors.New("unknown column")
+	// ErrUnsupportedRowPainter is reported when SetRowPainter was called with
+	// something other than a RowPainter or a RowPainterWithAttributes.
+	ErrUnsupportedRowPainter = errors.New("unsupported row painter")
+)
+
+// ValidationError contains all the problems found by Validate().
+type ValidationError struct {
+	Errors []error
+}
+
+// Error returns all the problems found in one line.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for idx, err := range e.Errors {
+		msgs[idx] = err.Error()
+	}
+	return fmt.Sprintf("table: %d invalid setting(s): %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual problems found.
+func (e *ValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+// Is returns true if any of the problems found matches the target. This lets
+// errors.Is() look into the problems with Go versions older than 1.20, which
+// do not know about Unwrap() []error.
+func (e *ValidationError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first problem that matches the target, and if one is found,
+// sets the target to it and returns true; refer to Is() for the reason.
+func (e *ValidationError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the settings of the Table that are otherwise silently
+// ignored when they are wrong, and returns a *ValidationError listing all the
+// problems found, or nil if there are none. It looks for:
+//   - ColumnConfig, SortBy and FilterBy entries referring to column names not
+//     found in the header (just the first Header row for SortBy and FilterBy,
+//     like while rendering), or to column numbers outside the table
+//   - ColumnConfig, SortBy and FilterBy entries with a Name and a Number
+//     that refer to different columns
+//   - ColumnGroups with an empty column range, or one outside the table
+//   - FilterBy entries with an invalid regular expression, or with a Value
+//     that is not a number for a numeric operator
+//   - row painters of an unsupported type passed to SetRowPainter
+//
+// Computed columns (see ColumnConfig.Compute) are considered to be part of
+// the table.
+func (t *Table) Validate() error {
+	columns := t.validationColumns()
+
+	var errs []error
+	for idx, colCfg := range t.columnConfigs {
+		if colCfg.Number == 0 && colCfg.Name == "" {
+			errs = append(errs, fmt.Errorf("ColumnConfig[%d]: neither Name nor Number set: %w", idx, ErrUnknownColumn))
+			continue
+		}
+		if err := columns.validate(columns.names, colCfg.Name, colCfg.Number); err != nil {
+			errs = append(errs, fmt.Errorf("ColumnConfig[%d]: %w", idx, err))
+		}
+	}
+	for idx, group := range t.columnGroups {
+		if group.FromCol < 1 || group.ToCol < group.FromCol || group.ToCol > columns.numColumns {
+			errs = append(errs, fmt.Errorf("ColumnGroups[%d]: %w range %d-%d (table has %d columns)",
+				idx, ErrUnknownColumn, group.FromCol, group.ToCol, columns.numColumns))
+		}
+	}
+	for idx, sortBy := range t.sortBy {
+		if err := columns.validate(columns.namesSortBy, sortBy.Name, sortBy.Number); err != nil {
+			errs = append(errs, fmt.Errorf("SortBy[%d]: %w", idx, err))
+		}
+	}
+	for idx, filterBy := range t.filterBy {
+		if err := columns.validate(columns.namesFilterBy, filterBy.Name, filterBy.Number); err != nil {
+			errs = append(errs, fmt.Errorf("FilterBy[%d]: %w", idx, err))
+		}
+		if err := validateFilterValue(filterBy); err != nil {
+			errs = append(errs, fmt.Errorf("FilterBy[%d]: %w", idx, err))
+		}
+	}
+	if t.rowPainterUnsupported != "" {
+		errs = append(errs, fmt.Errorf("SetRowPainter: %w of type %s", ErrUnsupportedRowPainter, t.rowPainterUnsupported))
+	}
+
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// validationColumns describes the columns in the Table for Validate(), with
+// the column names looked up the same way as while rendering.
+type validationColumns struct {
+	// names maps the column names found in any of the header rows (as looked
+	// up by ColumnConfig) to their numbers
+	names map[string]int
+	// namesFilterBy maps the column names found in the first header row, and
+	// the virtual columns (as looked up by FilterBy) to their numbers
+	namesFilterBy map[string]int
+	// namesSortBy maps the column names found in the first header row, which
+	// has the virtual columns as well (as looked up by SortBy) to their numbers
+	namesSortBy map[string]int
+	// numColumns is the number of columns including the virtual ones
+	numColumns int
+}
+
+func (t *Table) validationColumns() validationColumns {
+	columns := validationColumns{
+		names:         make(map[string]int),
+		namesFilterBy: make(map[string]int),
+		namesSortBy:   make(map[string]int),
+		numColumns:    t.getNumColumnsRaw(),
+	}
+	addName := func(names map[string]int, name string, number int) {
+		if _, ok := names[name]; !ok {
+			names[name] = number
+		}
+	}
+	for rowIdx, row := range t.rowsHeaderRaw {
+		for colIdx, col := range row {
+			addName(columns.names, fmt.Sprint(col), colIdx+1)
+			if rowIdx == 0 {
+				addName(columns.namesFilterBy, fmt.Sprint(col), colIdx+1)
+				addName(columns.namesSortBy, fmt.Sprint(col), colIdx+1)
+			}
+		}
+	}
+	for _, colCfg := range t.columnConfigs {
+		if _, ok := columns.names[colCfg.Name]; !ok && colCfg.Number == 0 && colCfg.Compute != nil && colCfg.Name != "" {
+			columns.numColumns++
+			columns.names[colCfg.Name] = columns.numColumns
+			columns.namesFilterBy[colCfg.Name] = columns.numColumns
+			if len(t.rowsHeaderRaw) > 0 {
+				columns.namesSortBy[colCfg.Name] = columns.numColumns
+			}
+		}
+	}
+	return columns
+}
+
+// validate checks the column name and number of a config against the given
+// column names; the number, when set, overrides the name.
+func (c validationColumns) validate(names map[string]int, name string, number int) error {
+	numberByName, nameFound := names[name]
+	if number != 0 && (number < 0 || number > c.numColumns) {
+		return fmt.Errorf("%w number %d (table has %d columns)", ErrUnknownColumn, number, c.numColumns)
+	}
+	if name != "" && !nameFound && number == 0 {
+		return fmt.Errorf("%w name %q", ErrUnknownColumn, name)
+	}
+	if name != "" && number != 0 && nameFound && numberByName != number {
+		return fmt.Errorf("%w: Name %q is column %d, but Number is %d", ErrConflictingColumn, name, numberByName, number)
+	}
+	return nil
+}
+
+func validateFilterValue(filterBy FilterBy) error {
+	if filterBy.CustomFilter != nil {
+		return nil
+	}
+
+	switch filterBy.Operator {
+	case GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual:
+		if _, err := strconv.ParseFloat(fmt.Sprint(filterBy.Value), 64); err == nil {
+			return nil
+		}
+		return fmt.Errorf("%w: %#v", ErrInvalidFilterValue, filterBy.Value)
+	case RegexMatch, RegexNotMatch:
+		pattern := fmt.Sprint(filterBy.Value)
+		if filterBy.IgnoreCase {
+			pattern = "(?i)" + pattern
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidFilterRegex, err)
+		}
+	}
+	return nil
+}
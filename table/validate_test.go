@@ -0,0 +1,160 @@
+package table
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "First Name", Align: text.AlignRight},
+			{Number: 4, Name: "Salary"},
+			{Number: 5},
+			{Name: "Bonus", Compute: func(row Row) interface{} { return 0 }},
+		})
+		tw.SetColumnGroups([]ColumnGroup{{Title: "Name", FromCol: 2, ToCol: 3}, {Title: "Pay", FromCol: 4, ToCol: 6}})
+		tw.SortBy([]SortBy{{Name: "Bonus"}, {Number: 6}})
+		tw.FilterBy([]FilterBy{
+			{Name: "Salary", Operator: GreaterThan, Value: "1000"},
+			{Name: "Last Name", Operator: RegexMatch, Value: "^S"},
+			{Name: "First Name", Operator: LessThan, CustomFilter: func(string) bool { return true }},
+		})
+		tw.SetRowPainter(func(row Row) text.Colors { return nil })
+
+		assert.NoError(t, tw.Validate())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Salry"},
+			{Number: 2, Name: "Salary"},
+			{Number: 7},
+			{Align: text.AlignRight},
+		})
+		tw.SetColumnGroups([]ColumnGroup{{Title: "Name", FromCol: 2, ToCol: 3}, {Title: "Pay", FromCol: 4, ToCol: 8}})
+		tw.SortBy([]SortBy{{Name: "Frist Name"}, {Number: -1}})
+		tw.FilterBy([]FilterBy{
+			{Name: "Salary", Operator: GreaterThan, Value: "lots"},
+			{Number: 3, Operator: RegexNotMatch, Value: "(Stark"},
+		})
+		tw.SetRowPainter(func(row Row) string { return "" })
+
+		err := tw.Validate()
+		require.Error(t, err)
+		var errValidation *ValidationError
+		require.True(t, errors.As(err, &errValidation))
+		expectedErrs := []error{
+			ErrUnknownColumn,
+			ErrConflictingColumn,
+			ErrUnknownColumn,
+			ErrUnknownColumn,
+			ErrUnknownColumn,
+			ErrUnknownColumn,
+			ErrUnknownColumn,
+			ErrInvalidFilterValue,
+			ErrInvalidFilterRegex,
+			ErrUnsupportedRowPainter,
+		}
+		require.Len(t, errValidation.Errors, len(expectedErrs))
+		for idx, expectedErr := range expectedErrs {
+			assert.True(t, errors.Is(errValidation.Errors[idx], expectedErr), errValidation.Errors[idx].Error())
+		}
+		assert.Equal(t, strings.Join([]string{
+			`table: 10 invalid setting(s): ColumnConfig[0]: unknown column name "Salry"`,
+			`ColumnConfig[1]: conflicting column name and number: Name "Salary" is column 4, but Number is 2`,
+			`ColumnConfig[2]: unknown column number 7 (table has 5 columns)`,
+			`ColumnConfig[3]: neither Name nor Number set: unknown column`,
+			`ColumnGroups[1]: unknown column range 4-8 (table has 5 columns)`,
+			`SortBy[0]: unknown column name "Frist Name"`,
+			`SortBy[1]: unknown column number -1 (table has 5 columns)`,
+			`FilterBy[0]: invalid value for numeric operator: "lots"`,
+			"FilterBy[1]: invalid regular expression: error parsing regexp: missing closing ): `(Stark`",
+			`SetRowPainter: unsupported row painter of type func(table.Row) string`,
+		}, "; "), err.Error())
+
+		tw.SetRowPainter(RowPainter(func(row Row) text.Colors { return nil }))
+		assert.Len(t, tw.Validate().(*ValidationError).Errors, len(expectedErrs)-1)
+	})
+
+	t.Run("names in the second header row", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(Row{"#", "Name", "Pay"})
+		tw.AppendHeader(Row{"", "First", "Salary"})
+		tw.AppendRows(testRows)
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Name: "Salary", Align: text.AlignCenter},
+			{Name: "Bonus", Compute: func(row Row) interface{} { return 0 }},
+		})
+		tw.SortBy([]SortBy{{Name: "Salary"}, {Name: "Bonus"}})
+		tw.FilterBy([]FilterBy{{Name: "First", Operator: Equal, Value: "Arya"}, {Name: "Bonus", Operator: Equal, Value: 0}})
+
+		err := tw.Validate()
+		require.Error(t, err)
+		assert.Equal(t, strings.Join([]string{
+			`table: 2 invalid setting(s): SortBy[0]: unknown column name "Salary"`,
+			`FilterBy[0]: unknown column name "First"`,
+		}, "; "), err.Error())
+	})
+
+	t.Run("errors.Is and errors.As", func(t *testing.T) {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.SortBy([]SortBy{{Name: "Salry"}})
+		tw.FilterBy([]FilterBy{{Name: "Salary", Operator: RegexMatch, Value: "("}})
+
+		err := tw.Validate()
+		assert.True(t, errors.Is(err, ErrUnknownColumn))
+		assert.True(t, errors.Is(err, ErrInvalidFilterRegex))
+		assert.False(t, errors.Is(err, ErrInvalidFilterValue))
+
+		// without relying on errors.Is() and errors.As() to use Unwrap()
+		errValidation := err.(*ValidationError)
+		assert.True(t, errValidation.Is(ErrUnknownColumn))
+		assert.True(t, errValidation.Is(ErrInvalidFilterRegex))
+		assert.False(t, errValidation.Is(ErrConflictingColumn))
+		var errWrapped interface{ Unwrap() error }
+		assert.True(t, errValidation.As(&errWrapped))
+		assert.Equal(t, errValidation.Errors[0], errWrapped)
+		var errNum *strconv.NumError
+		assert.False(t, errValidation.As(&errNum))
+	})
+}
+
+func TestTable_SetStrictMode(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.SortBy([]SortBy{{Name: "Salry"}})
+
+	// ignored silently by default
+	assert.NotPanics(t, func() { tw.Render() })
+	var out strings.Builder
+	_, err := tw.RenderTo(&out)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, out.String())
+
+	tw.SetStrictMode(true)
+	assert.PanicsWithError(t, tw.Validate().Error(), func() { tw.Render() })
+	assert.PanicsWithError(t, tw.Validate().Error(), func() { tw.RenderCSV() })
+	out.Reset()
+	n, err := tw.RenderTo(&out)
+	assert.Zero(t, n)
+	assert.True(t, errors.Is(err.(*ValidationError).Errors[0], ErrUnknownColumn))
+	assert.Empty(t, out.String())
+
+	tw.SortBy([]SortBy{{Name: "Salary"}})
+	assert.NotPanics(t, func() { tw.Render() })
+}
@@ -2,39 +2,69 @@ package table
 
 import (
 	"io"
+
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 // Writer declares the interfaces that can be used to set up and render a table.
 type Writer interface {
+	AppendChildRow(parentIdx int, row Row, configs ...RowConfig) int
 	AppendFooter(row Row, configs ...RowConfig)
 	AppendHeader(row Row, configs ...RowConfig)
+	AppendNote(note string)
 	AppendRow(row Row, configs ...RowConfig)
 	AppendRows(rows []Row, configs ...RowConfig)
+	AppendSection(title string, colors ...text.Color)
 	AppendSeparator()
+	DeleteRow(idx int) bool
+	Describe() Writer
 	FilterBy(filterBy []FilterBy)
+	FindRow(keyColumn int, key interface{}) (int, Row)
+	FindRowByName(keyColumn string, key interface{}) (int, Row)
 	ImportGrid(grid interface{}) bool
+	InsertRow(idx int, row Row, configs ...RowConfig) bool
 	Length() int
+	Limit(head int, tail int)
+	Offset(n int)
 	Pager(opts ...PagerOption) Pager
 	Render() string
 	RenderCSV() string
+	RenderCSVTo(w io.Writer) (int64, error)
 	RenderHTML() string
+	RenderHTMLTo(w io.Writer) (int64, error)
+	RenderHTMLDocument(opts HTMLDocumentOptions) string
+	RenderHTMLDocumentTo(w io.Writer, opts HTMLDocumentOptions) (int64, error)
 	RenderMarkdown() string
+	RenderMarkdownTo(w io.Writer) (int64, error)
+	RenderSQL(tableName string, dialect SQLDialect) string
+	RenderSQLTo(w io.Writer, tableName string, dialect SQLDialect) (int64, error)
+	RenderTo(w io.Writer) (int64, error)
 	RenderTSV() string
+	RenderTSVTo(w io.Writer) (int64, error)
+	RenderVertical() string
+	RenderVerticalTo(w io.Writer) (int64, error)
 	ResetFooters()
 	ResetHeaders()
 	ResetRows()
+	Rows() []Row
 	SetAutoIndex(autoIndex bool)
 	SetCaption(format string, a ...interface{})
 	SetColumnConfigs(configs []ColumnConfig)
+	SetColumnGroups(groups []ColumnGroup)
 	SetIndexColumn(colNum int)
 	SetOutputMirror(mirror io.Writer)
 	SetRowPainter(painter interface{})
+	SetStrictMode(strict bool)
 	SetStyle(style Style)
 	SetTitle(format string, a ...interface{})
 	SortBy(sortBy []SortBy)
 	Style() *Style
 	SuppressEmptyColumns()
 	SuppressTrailingSpaces()
+	UpdateRow(idx int, row Row, configs ...RowConfig) bool
+	UpsertRow(keyColumn int, row Row, configs ...RowConfig) int
+	UpsertRowByName(keyColumn string, row Row, configs ...RowConfig) int
+	Validate() error
 
 	// deprecated; in favor if Style().Size.WidthMax
 	SetAllowedRowLength(length int)
@@ -16,8 +16,13 @@ const (
 	AlignJustify              // "justify   it"
 	AlignRight                // "       right"
 	AlignAuto                 // AlignRight for numbers, AlignLeft for the rest
+	AlignDecimal              // "    12.5    " lined up on the decimal separator
 )
 
+// DecimalSeparatorDefault is the decimal separator used by AlignDecimal when
+// none is specified.
+const DecimalSeparatorDefault = "."
+
 // Apply aligns the text as directed. For ex.:
 //   - AlignDefault.Apply("Jon Snow", 12) returns "Jon Snow    "
 //   - AlignLeft.Apply("Jon Snow",    12) returns "Jon Snow    "
@@ -25,6 +30,9 @@ const (
 //   - AlignJustify.Apply("Jon Snow", 12) returns "Jon     Snow"
 //   - AlignRight.Apply("Jon Snow",   12) returns "    Jon Snow"
 //   - AlignAuto.Apply("Jon Snow",    12) returns "Jon Snow    "
+//
+// AlignDecimal needs to know the other values in the column to line them up;
+// use ApplyDecimal for that. On its own, it behaves like AlignRight.
 func (a Align) Apply(text string, maxLength int) string {
 	aComputed := a
 	if aComputed == AlignAuto {
@@ -53,6 +61,51 @@ func (a Align) Apply(text string, maxLength int) string {
 	return padText(text, padding, 0)
 }
 
+// ApplyDecimal aligns the text on the decimal separator so that it lines up
+// with the other values in its column. intLength and fracLength are the
+// display-widths of the widest integer part and widest fraction part
+// (separator included) in the column, as returned by DecimalLengths. The
+// result is then right-aligned to maxLength. For ex.:
+//   - ApplyDecimal("3", ".", 4, 4, 8) returns "   3    "
+//   - ApplyDecimal("12.5", ".", 4, 4, 8) returns "  12.5  "
+//   - ApplyDecimal("1000.125", ".", 4, 4, 8) returns "1000.125"
+func ApplyDecimal(text string, separator string, intLength int, fracLength int, maxLength int) string {
+	text = strings.TrimSpace(text)
+	integer, fraction := SplitDecimal(text, separator)
+	padLeft := intLength - StringWidthWithoutEscSequences(integer)
+	padRight := fracLength - StringWidthWithoutEscSequences(fraction)
+	if padLeft < 0 {
+		padLeft = 0
+	}
+	if padRight < 0 {
+		padRight = 0
+	}
+	padLeft += maxLength - StringWidthWithoutEscSequences(text) - padLeft - padRight
+	return padText(text, padLeft, padRight)
+}
+
+// DecimalLengths returns the display-widths of the integer part and the
+// fraction part (separator included) of the text.
+func DecimalLengths(text string, separator string) (int, int) {
+	integer, fraction := SplitDecimal(text, separator)
+	return StringWidthWithoutEscSequences(integer), StringWidthWithoutEscSequences(fraction)
+}
+
+// SplitDecimal splits the (trimmed) text at the last decimal separator into the
+// integer part and the fraction part, with the latter including the separator.
+// Text without the separator is returned as the integer part. An empty
+// separator is treated as DecimalSeparatorDefault.
+func SplitDecimal(text string, separator string) (string, string) {
+	if separator == "" {
+		separator = DecimalSeparatorDefault
+	}
+	text = strings.TrimSpace(text)
+	if idx := strings.LastIndex(text, separator); idx >= 0 {
+		return text[:idx], text[idx:]
+	}
+	return text, ""
+}
+
 // padText returns the text with the given number of spaces on either side;
 // non-positive counts add nothing.
 func padText(text string, left int, right int) string {
@@ -83,6 +136,8 @@ func (a Align) HTMLProperty() string {
 		return "align=\"justify\""
 	case AlignRight:
 		return "align=\"right\""
+	case AlignDecimal:
+		return "align=\"char\" char=\"" + DecimalSeparatorDefault + "\""
 	default:
 		return ""
 	}
@@ -103,7 +158,7 @@ func (a Align) MarkdownProperty(minLength ...int) string {
 		return ":" + dashes + " "
 	case AlignCenter:
 		return ":" + dashes + ":"
-	case AlignRight:
+	case AlignRight, AlignDecimal:
 		return " " + dashes + ":"
 	default:
 		return " " + dashes + " "
@@ -116,7 +171,7 @@ func (a Align) trimString(text string) string {
 		if strings.HasSuffix(text, " ") {
 			return strings.TrimRight(text, " ")
 		}
-	case AlignRight:
+	case AlignRight, AlignDecimal:
 		if strings.HasPrefix(text, " ") {
 			return strings.TrimLeft(text, " ")
 		}
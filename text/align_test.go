@@ -65,6 +65,56 @@ func TestAlign_Apply(t *testing.T) {
 	assert.Equal(t, "        +.43", AlignAuto.Apply("+.43", 12))
 	assert.Equal(t, "       +5.43", AlignAuto.Apply("+5.43", 12))
 	assert.Equal(t, "+5.43x      ", AlignAuto.Apply("+5.43x", 12))
+
+	// AlignDecimal on its own is the same as AlignRight
+	assert.Equal(t, "    1000.125", AlignDecimal.Apply("1000.125", 12))
+	assert.Equal(t, "        12.5", AlignDecimal.Apply(" 12.5", 12))
+}
+
+func TestApplyDecimal(t *testing.T) {
+	assert.Equal(t, "   3    ", ApplyDecimal("3", ".", 4, 4, 8))
+	assert.Equal(t, "  12.5  ", ApplyDecimal("12.5", ".", 4, 4, 8))
+	assert.Equal(t, "1000.125", ApplyDecimal("1000.125", ".", 4, 4, 8))
+	assert.Equal(t, "      12.5  ", ApplyDecimal(" 12.5 ", ".", 4, 4, 12))
+	assert.Equal(t, "  12,5  ", ApplyDecimal("12,5", ",", 4, 4, 8))
+	assert.Equal(t, "1.234,5 ", ApplyDecimal("1.234,5", ",", 5, 3, 8))
+	assert.Equal(t, " N/A    ", ApplyDecimal("N/A", ".", 4, 4, 8))
+	assert.Equal(t, "  \x1b[31m-1.5\x1b[0m  ", ApplyDecimal("\x1b[31m-1.5\x1b[0m", ".", 4, 4, 8))
+
+	// values wider than the given lengths are not truncated
+	assert.Equal(t, "12345.6", ApplyDecimal("12345.6", ".", 4, 2, 4))
+}
+
+func TestDecimalLengths(t *testing.T) {
+	integer, fraction := DecimalLengths("1000.125", ".")
+	assert.Equal(t, 4, integer)
+	assert.Equal(t, 4, fraction)
+
+	integer, fraction = DecimalLengths("1.234.567,89", ",")
+	assert.Equal(t, 9, integer)
+	assert.Equal(t, 3, fraction)
+
+	integer, fraction = DecimalLengths("42", "")
+	assert.Equal(t, 2, integer)
+	assert.Equal(t, 0, fraction)
+}
+
+func TestSplitDecimal(t *testing.T) {
+	integer, fraction := SplitDecimal(" 1000.125 ", ".")
+	assert.Equal(t, "1000", integer)
+	assert.Equal(t, ".125", fraction)
+
+	integer, fraction = SplitDecimal("1.234.567,89", ",")
+	assert.Equal(t, "1.234.567", integer)
+	assert.Equal(t, ",89", fraction)
+
+	integer, fraction = SplitDecimal("1.234.567", "")
+	assert.Equal(t, "1.234", integer)
+	assert.Equal(t, ".567", fraction)
+
+	integer, fraction = SplitDecimal("N/A", ".")
+	assert.Equal(t, "N/A", integer)
+	assert.Equal(t, "", fraction)
 }
 
 func TestAlign_Apply_JustifyCJKOverflow(t *testing.T) {
@@ -134,6 +184,7 @@ func TestAlign_HTMLProperty(t *testing.T) {
 		AlignCenter:  "center",
 		AlignJustify: "justify",
 		AlignRight:   "right",
+		AlignDecimal: `align="char" char="."`,
 	}
 	for align, htmlStyle := range aligns {
 		assert.Contains(t, align.HTMLProperty(), htmlStyle)
@@ -161,6 +212,7 @@ func TestAlign_MarkdownProperty(t *testing.T) {
 		AlignCenter:  ":---:",
 		AlignJustify: " --- ",
 		AlignRight:   " ---:",
+		AlignDecimal: " ---:",
 	}
 	for align, markdownSeparator := range aligns {
 		assert.Contains(t, align.MarkdownProperty(), markdownSeparator)
@@ -193,7 +245,7 @@ func FuzzAlign_Apply(f *testing.F) {
 	f.Add("a b c", 0)
 	f.Add("\x1b[33mJon Snow\x1b[0m", 12)
 
-	aligns := []Align{AlignDefault, AlignLeft, AlignCenter, AlignJustify, AlignRight, AlignAuto}
+	aligns := []Align{AlignDefault, AlignLeft, AlignCenter, AlignJustify, AlignRight, AlignAuto, AlignDecimal}
 	f.Fuzz(func(t *testing.T, s string, maxLength int) {
 		// keep maxLength in a sane range; negative/huge values are not the
 		// concern of this fuzz target.
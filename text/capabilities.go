@@ -0,0 +1,155 @@
+package text
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ColorDepth denotes the number of colors a terminal can render.
+type ColorDepth int
+
+// ColorDepth enumerations
+const (
+	ColorDepthNone      ColorDepth = iota // no colors at all
+	ColorDepth16                          // the 8 basic colors + hi-intensity variants
+	ColorDepth256                         // the 256-color palette
+	ColorDepthTrueColor                   // 24-bit RGB colors
+)
+
+// Capabilities describes what the terminal attached to the output can render.
+type Capabilities struct {
+	ColorDepth ColorDepth // number of colors supported
+	IsTTY      bool       // is the output a terminal?
+	Unicode    bool       // is the locale UTF-8 (can box-drawing runes be used)?
+}
+
+// DetectCapabilities detects the Capabilities of the terminal attached to
+// os.Stdout using the following rules:
+//   - FORCE_COLOR set to a truthy value enables colors even if the output is
+//     not a TTY; the values "2" and "3" force 256-colors and true-colors
+//   - NO_COLOR set to a non-empty value (other than "0") disables colors
+//   - TERM set to "dumb", or the output not being a TTY disables colors
+//   - COLORTERM set to "truecolor"/"24bit", or TERM ending in "-direct"
+//     enables true-colors; TERM ending in "-256color" enables 256-colors
+//   - the locale (LC_ALL, LC_CTYPE or LANG) using UTF-8 enables Unicode
+func DetectCapabilities() Capabilities {
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+	caps := detectCapabilities(os.Getenv, isTTY)
+	if caps.ColorDepth != ColorDepthNone && !areANSICodesSupported() {
+		caps.ColorDepth = ColorDepthNone
+	}
+	return caps
+}
+
+func detectCapabilities(getEnv func(string) string, isTTY bool) Capabilities {
+	return Capabilities{
+		ColorDepth: detectColorDepth(getEnv, isTTY),
+		IsTTY:      isTTY,
+		Unicode:    detectUnicode(getEnv),
+	}
+}
+
+func detectColorDepth(getEnv func(string) string, isTTY bool) ColorDepth {
+	depth := ColorDepth16
+	colorTerm := strings.ToLower(getEnv("COLORTERM"))
+	termName := strings.ToLower(getEnv("TERM"))
+	if colorTerm == "truecolor" || colorTerm == "24bit" || strings.HasSuffix(termName, "-direct") {
+		depth = ColorDepthTrueColor
+	} else if strings.HasSuffix(termName, "-256color") {
+		depth = ColorDepth256
+	}
+
+	// FORCE_COLOR takes precedence - if set to a truthy value, enable colors
+	switch forceColor := getEnv("FORCE_COLOR"); forceColor {
+	case "", "0", "false":
+	case "2":
+		return ColorDepth256
+	case "3":
+		return ColorDepthTrueColor
+	default:
+		return depth
+	}
+
+	// NO_COLOR: if set to any non-empty value (except "0"), disable colors
+	// Note: "0" is treated as "not set" to allow explicit enabling via NO_COLOR=0
+	if noColor := getEnv("NO_COLOR"); noColor != "" && noColor != "0" {
+		return ColorDepthNone
+	}
+	if termName == "dumb" || !isTTY {
+		return ColorDepthNone
+	}
+	return depth
+}
+
+func detectUnicode(getEnv func(string) string) bool {
+	for _, envVar := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := strings.ToLower(getEnv(envVar)); locale != "" {
+			return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+		}
+	}
+	// the Windows console does not use the locale variables, and modern
+	// versions of it support Unicode
+	return runtime.GOOS == "windows"
+}
+
+// Degrade returns the Colors converted to the closest ones that can be
+// rendered with the given ColorDepth. With ColorDepthNone, nil is returned so
+// that no escape sequences get rendered at all.
+func (c Colors) Degrade(depth ColorDepth) Colors {
+	if depth == ColorDepthNone || c == nil {
+		return nil
+	}
+	rsp := make(Colors, len(c))
+	for idx, color := range c {
+		rsp[idx] = color.Degrade(depth)
+	}
+	return rsp
+}
+
+// Degrade returns the closest Color that can be rendered with the given
+// ColorDepth. With ColorDepthNone, Reset is returned.
+func (c Color) Degrade(depth ColorDepth) Color {
+	if depth == ColorDepthNone {
+		return Reset
+	}
+	if r, g, b, ok := c.rgb(); ok && depth < ColorDepthTrueColor {
+		if c >= bgRGBStart {
+			c = Bg256Color(rgbToColor256(r, g, b))
+		} else {
+			c = Fg256Color(rgbToColor256(r, g, b))
+		}
+	}
+	if depth == ColorDepth16 {
+		if c >= fg256Start && c < fg256Start+256 {
+			return FgBlack + color256To16(int(c-fg256Start))
+		}
+		if c >= bg256Start && c < bg256Start+256 {
+			return BgBlack + color256To16(int(c-bg256Start))
+		}
+	}
+	return c
+}
+
+// color256To16 returns the offset (from FgBlack/BgBlack) of the basic or
+// hi-intensity color closest to the given 256-color.
+func color256To16(index int) Color {
+	if index >= 16 {
+		r, g, b := color256ToRGB(index)
+		closest, closestDistance := 0, -1
+		for idx := 0; idx < 16; idx++ {
+			cr, cg, cb := color256ToRGB(idx)
+			distance := (cr-r)*(cr-r) + (cg-g)*(cg-g) + (cb-b)*(cb-b)
+			if closestDistance < 0 || distance < closestDistance {
+				closest, closestDistance = idx, distance
+			}
+		}
+		index = closest
+	}
+	if index >= 8 {
+		return Color(60 + index - 8) // FgHiBlack - FgBlack == 60
+	}
+	return Color(index)
+}
@@ -0,0 +1,141 @@
+package text
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/term"
+)
+
+func TestColor_Degrade(t *testing.T) {
+	assert.Equal(t, Reset, FgRed.Degrade(ColorDepthNone))
+	assert.Equal(t, FgRed, FgRed.Degrade(ColorDepth16))
+	assert.Equal(t, Bold, Bold.Degrade(ColorDepth16))
+	assert.Equal(t, Fg256Color(208), Fg256Color(208).Degrade(ColorDepth256))
+	assert.Equal(t, Fg256Color(208), Fg256Color(208).Degrade(ColorDepthTrueColor))
+
+	assert.Equal(t, FgRed, Fg256Color(1).Degrade(ColorDepth16))
+	assert.Equal(t, FgHiRed, Fg256Color(9).Degrade(ColorDepth16))
+	assert.Equal(t, BgHiWhite, Bg256Color(15).Degrade(ColorDepth16))
+	assert.Equal(t, FgHiRed, Fg256Color(196).Degrade(ColorDepth16))
+	assert.Equal(t, BgBlue, Bg256Color(18).Degrade(ColorDepth16))
+	assert.Equal(t, FgBlack, Fg256Color(232).Degrade(ColorDepth16))
+	assert.Equal(t, BgHiBlack, Bg256Color(244).Degrade(ColorDepth16))
+}
+
+func TestColors_Degrade(t *testing.T) {
+	colors := Colors{Bold, Fg256Color(196), Bg256Color(21)}
+	assert.Nil(t, colors.Degrade(ColorDepthNone))
+	assert.Nil(t, Colors(nil).Degrade(ColorDepth16))
+	assert.Equal(t, Colors{Bold, FgHiRed, BgHiBlue}, colors.Degrade(ColorDepth16))
+	assert.Equal(t, colors, colors.Degrade(ColorDepth256))
+	assert.Equal(t, Colors{Bold, Fg256Color(196), Bg256Color(21)}, colors, "should not modify the original")
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	caps := DetectCapabilities()
+	assert.Equal(t, term.IsTerminal(int(os.Stdout.Fd())), caps.IsTTY)
+	if !caps.IsTTY && os.Getenv("FORCE_COLOR") == "" {
+		assert.Equal(t, ColorDepthNone, caps.ColorDepth)
+	}
+}
+
+func Test_detectCapabilities(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		isTTY    bool
+		expected Capabilities
+	}{
+		{
+			name:     "tty",
+			env:      map[string]string{"TERM": "xterm", "LANG": "en_US.UTF-8"},
+			isTTY:    true,
+			expected: Capabilities{ColorDepth: ColorDepth16, IsTTY: true, Unicode: true},
+		},
+		{
+			name:     "tty with 256 colors",
+			env:      map[string]string{"TERM": "xterm-256color", "LANG": "C.utf8"},
+			isTTY:    true,
+			expected: Capabilities{ColorDepth: ColorDepth256, IsTTY: true, Unicode: true},
+		},
+		{
+			name:     "tty with true colors",
+			env:      map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor", "LANG": "C"},
+			isTTY:    true,
+			expected: Capabilities{ColorDepth: ColorDepthTrueColor, IsTTY: true},
+		},
+		{
+			name:     "tty with direct colors",
+			env:      map[string]string{"TERM": "xterm-direct", "LC_ALL": "C", "LANG": "en_US.UTF-8"},
+			isTTY:    true,
+			expected: Capabilities{ColorDepth: ColorDepthTrueColor, IsTTY: true},
+		},
+		{
+			name:     "dumb terminal",
+			env:      map[string]string{"TERM": "dumb", "LC_CTYPE": "en_US.UTF-8"},
+			isTTY:    true,
+			expected: Capabilities{ColorDepth: ColorDepthNone, IsTTY: true, Unicode: true},
+		},
+		{
+			name:     "serial console",
+			env:      map[string]string{"TERM": "vt100", "LANG": "POSIX"},
+			isTTY:    true,
+			expected: Capabilities{ColorDepth: ColorDepth16, IsTTY: true},
+		},
+		{
+			name:     "NO_COLOR",
+			env:      map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1", "LANG": "en_US.UTF-8"},
+			isTTY:    true,
+			expected: Capabilities{ColorDepth: ColorDepthNone, IsTTY: true, Unicode: true},
+		},
+		{
+			name:     "not a tty",
+			env:      map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8"},
+			expected: Capabilities{ColorDepth: ColorDepthNone, Unicode: true},
+		},
+		{
+			name:     "FORCE_COLOR without a tty",
+			env:      map[string]string{"TERM": "xterm-256color", "FORCE_COLOR": "1", "NO_COLOR": "1", "LANG": "en_US.UTF-8"},
+			expected: Capabilities{ColorDepth: ColorDepth256, Unicode: true},
+		},
+		{
+			name:     "FORCE_COLOR=2",
+			env:      map[string]string{"TERM": "dumb", "FORCE_COLOR": "2", "LANG": "en_US.UTF-8"},
+			expected: Capabilities{ColorDepth: ColorDepth256, Unicode: true},
+		},
+		{
+			name:     "FORCE_COLOR=3",
+			env:      map[string]string{"FORCE_COLOR": "3", "LANG": "en_US.UTF-8"},
+			expected: Capabilities{ColorDepth: ColorDepthTrueColor, Unicode: true},
+		},
+		{
+			name:     "FORCE_COLOR=false",
+			env:      map[string]string{"FORCE_COLOR": "false", "LANG": "en_US.UTF-8"},
+			expected: Capabilities{ColorDepth: ColorDepthNone, Unicode: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			getEnv := func(key string) string { return test.env[key] }
+			assert.Equal(t, test.expected, detectCapabilities(getEnv, test.isTTY))
+		})
+	}
+
+	t.Run("no locale", func(t *testing.T) {
+		getEnv := func(key string) string { return "" }
+		assert.Equal(t, runtime.GOOS == "windows", detectCapabilities(getEnv, true).Unicode)
+	})
+}
+
+func TestColor_Degrade_RGB(t *testing.T) {
+	assert.Equal(t, FgHex("#ff8800"), FgHex("#ff8800").Degrade(ColorDepthTrueColor))
+	assert.Equal(t, Fg256Color(214), FgHex("#ff8800").Degrade(ColorDepth256))
+	assert.Equal(t, Bg256Color(244), BgHex("#808080").Degrade(ColorDepth256))
+	assert.Equal(t, Fg256Color(231), FgHex("#fff").Degrade(ColorDepth256))
+	assert.Equal(t, FgHiYellow, FgHex("#ff8800").Degrade(ColorDepth16))
+	assert.Equal(t, BgBlue, BgHex("#000080").Degrade(ColorDepth16))
+	assert.Equal(t, Reset, FgHex("#ff8800").Degrade(ColorDepthNone))
+}
@@ -12,6 +12,11 @@ import (
 // colorsEnabled is true if colors are enabled and supported by the terminal.
 var colorsEnabled = areColorsOnInTheEnv() && areANSICodesSupported()
 
+// colorDepthRGB is the ColorDepth the RGB (true-color) colors get converted to
+// by DegradeRGBColors; they fall back to the closest 256-color or 16-color on
+// terminals that do not advertise true-color support.
+var colorDepthRGB = detectColorDepthRGB()
+
 // DisableColors (forcefully) disables color coding globally.
 func DisableColors() {
 	colorsEnabled = false
@@ -22,24 +27,107 @@ func EnableColors() {
 	colorsEnabled = true
 }
 
-// areColorsOnInTheEnv returns true if colors are not disabled using
-// well known environment variables.
-func areColorsOnInTheEnv() bool {
-	// FORCE_COLOR takes precedence - if set to a truthy value, enable colors
-	forceColor := os.Getenv("FORCE_COLOR")
-	if forceColor != "" && forceColor != "0" && forceColor != "false" {
-		return true
+// SetColorDepthRGB (forcefully) sets the ColorDepth used to render RGB colors
+// (created using FgRGB, BgRGB, FgHex or BgHex) on the terminal globally; refer
+// to DegradeRGBColors. By default, RGB colors are rendered as-is only if the
+// environment advertises true-color support (COLORTERM=truecolor for ex.),
+// and are replaced with the closest 256-color or 16-color otherwise.
+func SetColorDepthRGB(depth ColorDepth) {
+	if depth < ColorDepth16 {
+		depth = ColorDepth16
 	}
+	colorDepthRGB = depth
+}
 
-	// NO_COLOR: if set to any non-empty value (except "0"), disable colors
-	// Note: "0" is treated as "not set" to allow explicit enabling via NO_COLOR=0
-	noColor := os.Getenv("NO_COLOR")
-	if noColor != "" && noColor != "0" {
-		return false
+// DegradeRGBColors returns the string with the RGB (true-color) colors in its
+// escape sequences replaced with the closest colors that can be rendered with
+// the ColorDepth detected from the environment (or set using
+// SetColorDepthRGB). The escape sequences generated for RGB colors always
+// have the exact color, so that it can be recovered (for HTML for ex.); this
+// is meant to be applied on the output written to the terminal, as done by
+// the Render functions in the list, progress and table packages.
+func DegradeRGBColors(str string) string {
+	return degradeRGBColors(str, colorDepthRGB)
+}
+
+func degradeRGBColors(str string, depth ColorDepth) string {
+	if depth >= ColorDepthTrueColor || !strings.Contains(str, "8;2;") {
+		return str
 	}
 
-	// Default: check TERM - if not "dumb", assume colors are supported
-	return os.Getenv("TERM") != "dumb"
+	var out strings.Builder
+	out.Grow(len(str))
+	for {
+		idx := strings.Index(str, EscapeStart)
+		if idx < 0 {
+			break
+		}
+		idxCodes := idx + len(EscapeStart)
+		idxStop := idxCodes
+		for idxStop < len(str) && (str[idxStop] == ';' || (str[idxStop] >= '0' && str[idxStop] <= '9')) {
+			idxStop++
+		}
+		out.WriteString(str[:idx])
+		if strings.HasPrefix(str[idxStop:], EscapeStop) {
+			out.WriteString(EscapeStart + degradeRGBCodes(str[idxCodes:idxStop], depth) + EscapeStop)
+			str = str[idxStop+len(EscapeStop):]
+		} else {
+			out.WriteString(str[idx:idxStop])
+			str = str[idxStop:]
+		}
+	}
+	out.WriteString(str)
+	return out.String()
+}
+
+// degradeRGBCodes converts the RGB colors in the codes of an escape sequence
+// (ex.: "1;38;2;255;136;0") to the given ColorDepth.
+func degradeRGBCodes(codes string, depth ColorDepth) string {
+	parts := strings.Split(codes, ";")
+	rsp := make([]string, 0, len(parts))
+	for idx := 0; idx < len(parts); idx++ {
+		if (parts[idx] == "38" || parts[idx] == "48") && idx+1 < len(parts) {
+			// skip over the 256-color codes, as the index could be "2"
+			if parts[idx+1] == "5" && idx+2 < len(parts) {
+				rsp = append(rsp, parts[idx:idx+3]...)
+				idx += 2
+				continue
+			}
+			if parts[idx+1] == "2" && idx+4 < len(parts) {
+				r, errR := strconv.Atoi(parts[idx+2])
+				g, errG := strconv.Atoi(parts[idx+3])
+				b, errB := strconv.Atoi(parts[idx+4])
+				color := FgRGB(r, g, b)
+				if parts[idx] == "48" {
+					color = BgRGB(r, g, b)
+				}
+				if errR == nil && errG == nil && errB == nil && color != Reset {
+					rsp = append(rsp, Colors(nil).colorToCode(color.Degrade(depth)))
+					idx += 4
+					continue
+				}
+			}
+		}
+		rsp = append(rsp, parts[idx])
+	}
+	return strings.Join(rsp, ";")
+}
+
+// detectColorDepthRGB returns the ColorDepth to render RGB colors with based
+// on the environment.
+func detectColorDepthRGB() ColorDepth {
+	if depth := detectColorDepth(os.Getenv, true); depth > ColorDepth16 {
+		return depth
+	}
+	return ColorDepth16
+}
+
+// areColorsOnInTheEnv returns true if colors are not disabled using
+// well known environment variables. Unlike DetectCapabilities, this does not
+// disable colors when the output is not a TTY, so that colored output can
+// still be piped to other programs (like "less -R").
+func areColorsOnInTheEnv() bool {
+	return detectColorDepth(os.Getenv, true) != ColorDepthNone
 }
 
 // The logic here is inspired from github.com/fatih/color; the following is
@@ -128,6 +216,21 @@ const (
 	bg256Start Color = 2000
 )
 
+// 24-bit RGB (true-color) support
+// Internal encoding for RGB colors (used by escape_seq_parser.go):
+// Foreground RGB: fgRGBStart + 0xRRGGBB
+// Background RGB: bgRGBStart + 0xRRGGBB
+const (
+	// fgRGBStart is the base value for RGB foreground colors.
+	// Use FgRGB(r, g, b) or FgHex(hex) to create a RGB foreground color.
+	fgRGBStart Color = 1 << 24
+	// bgRGBStart is the base value for RGB background colors.
+	// Use BgRGB(r, g, b) or BgHex(hex) to create a RGB background color.
+	bgRGBStart Color = 1 << 25
+	// rgbMax is the largest RGB value (0xFFFFFF).
+	rgbMax Color = 1<<24 - 1
+)
+
 // CSSClasses returns the CSS class names for the color.
 func (c Color) CSSClasses() string {
 	// Check for 256-color and convert to RGB-based class
@@ -141,6 +244,7 @@ func (c Color) CSSClasses() string {
 		r, g, b := color256ToRGB(colorIndex)
 		return fmt.Sprintf("bg-256-%d-%d-%d", r, g, b)
 	}
+	// RGB colors cannot use classes, and are rendered using CSSStyle instead
 	// Existing behavior for standard colors
 	if class, ok := colorCSSClassMap[c]; ok {
 		return class
@@ -148,8 +252,25 @@ func (c Color) CSSClasses() string {
 	return ""
 }
 
+// CSSStyle returns the inline CSS style for the color; only RGB colors need
+// one, as every other color is rendered using the classes from CSSClasses.
+func (c Color) CSSStyle() string {
+	if r, g, b, ok := c.rgb(); ok {
+		if c >= bgRGBStart {
+			return fmt.Sprintf("background-color: #%02x%02x%02x;", r, g, b)
+		}
+		return fmt.Sprintf("color: #%02x%02x%02x;", r, g, b)
+	}
+	return ""
+}
+
 // EscapeSeq returns the ANSI escape sequence for the color.
 func (c Color) EscapeSeq() string {
+	// RGB colors are rendered as is, and fall back to 256/16 colors on the
+	// terminal output only (refer to DegradeRGBColors)
+	if r, g, b, ok := c.rgb(); ok {
+		return fmt.Sprintf("%s%s;2;%d;%d;%d%s", EscapeStart, c.rgbCode(), r, g, b, EscapeStop)
+	}
 	// Check if it's a 256-color foreground (1000-1255)
 	if c >= fg256Start && c < fg256Start+256 {
 		colorIndex := int(c - fg256Start)
@@ -164,13 +285,10 @@ func (c Color) EscapeSeq() string {
 	return EscapeStart + strconv.Itoa(int(c)) + EscapeStop
 }
 
-// HTMLProperty returns the "class" attribute for the color.
+// HTMLProperty returns the "class" attribute for the color, or the "style"
+// attribute for RGB colors.
 func (c Color) HTMLProperty() string {
-	classes := c.CSSClasses()
-	if classes == "" {
-		return ""
-	}
-	return fmt.Sprintf("class=\"%s\"", classes)
+	return htmlProperty(c.CSSClasses(), c.CSSStyle())
 }
 
 // Sprint colorizes and prints the given string(s).
@@ -209,6 +327,20 @@ func (c Colors) CSSClasses() string {
 	return strings.Join(classes, " ")
 }
 
+// CSSStyle returns the inline CSS style for the RGB colors in the set.
+func (c Colors) CSSStyle() string {
+	var styles []string
+	for _, color := range c {
+		if style := color.CSSStyle(); style != "" {
+			styles = append(styles, style)
+		}
+	}
+	if len(styles) > 1 {
+		sort.Strings(styles)
+	}
+	return strings.Join(styles, " ")
+}
+
 // EscapeSeq returns the ANSI escape sequence for the colors set.
 func (c Colors) EscapeSeq() string {
 	if len(c) == 0 {
@@ -240,17 +372,19 @@ func (c Colors) colorToCode(color Color) string {
 		colorIndex := int(color - bg256Start)
 		return fmt.Sprintf("48;5;%d", colorIndex)
 	}
+	// Check if it's a RGB color, which is rendered as is (refer to
+	// DegradeRGBColors)
+	if r, g, b, ok := color.rgb(); ok {
+		return fmt.Sprintf("%s;2;%d;%d;%d", color.rgbCode(), r, g, b)
+	}
 	// Regular color
 	return strconv.Itoa(int(color))
 }
 
-// HTMLProperty returns the "class" attribute for the colors.
+// HTMLProperty returns the "class" attribute for the colors, along with the
+// "style" attribute for the RGB colors in the set.
 func (c Colors) HTMLProperty() string {
-	classes := c.CSSClasses()
-	if classes == "" {
-		return ""
-	}
-	return fmt.Sprintf("class=\"%s\"", classes)
+	return htmlProperty(c.CSSClasses(), c.CSSStyle())
 }
 
 // Sprint colorizes and prints the given string(s).
@@ -263,6 +397,17 @@ func (c Colors) Sprintf(format string, a ...interface{}) string {
 	return colorize(fmt.Sprintf(format, a...), c.EscapeSeq())
 }
 
+func htmlProperty(classes string, style string) string {
+	var attrs []string
+	if classes != "" {
+		attrs = append(attrs, fmt.Sprintf("class=\"%s\"", classes))
+	}
+	if style != "" {
+		attrs = append(attrs, fmt.Sprintf("style=\"%s\"", style))
+	}
+	return strings.Join(attrs, " ")
+}
+
 func colorize(s string, escapeSeq string) string {
 	if !colorsEnabled || escapeSeq == "" {
 		return s
@@ -310,6 +455,64 @@ func Bg256RGB(r, g, b int) Color {
 	return Bg256Color(index)
 }
 
+// FgRGB returns a foreground 24-bit RGB (true-color) Color value.
+// Each RGB component must be in the range 0-255.
+func FgRGB(r, g, b int) Color {
+	if r < 0 || r > 255 || g < 0 || g > 255 || b < 0 || b > 255 {
+		return Reset
+	}
+	return fgRGBStart + Color(r<<16|g<<8|b)
+}
+
+// BgRGB returns a background 24-bit RGB (true-color) Color value.
+// Each RGB component must be in the range 0-255.
+func BgRGB(r, g, b int) Color {
+	if r < 0 || r > 255 || g < 0 || g > 255 || b < 0 || b > 255 {
+		return Reset
+	}
+	return bgRGBStart + Color(r<<16|g<<8|b)
+}
+
+// FgHex returns a foreground 24-bit RGB (true-color) Color value from a hex
+// code like "#ff8800", "ff8800" or "#f80". Returns Reset for invalid codes.
+func FgHex(hex string) Color {
+	if r, g, b, ok := parseHexColor(strings.TrimPrefix(hex, "#")); ok {
+		return FgRGB(r, g, b)
+	}
+	return Reset
+}
+
+// BgHex returns a background 24-bit RGB (true-color) Color value from a hex
+// code like "#ff8800", "ff8800" or "#f80". Returns Reset for invalid codes.
+func BgHex(hex string) Color {
+	if r, g, b, ok := parseHexColor(strings.TrimPrefix(hex, "#")); ok {
+		return BgRGB(r, g, b)
+	}
+	return Reset
+}
+
+// rgb returns the RGB components of a RGB (true-color) Color.
+func (c Color) rgb() (r, g, b int, ok bool) {
+	var value Color
+	switch {
+	case c >= fgRGBStart && c <= fgRGBStart+rgbMax:
+		value = c - fgRGBStart
+	case c >= bgRGBStart && c <= bgRGBStart+rgbMax:
+		value = c - bgRGBStart
+	default:
+		return 0, 0, 0, false
+	}
+	return int(value >> 16 & 0xff), int(value >> 8 & 0xff), int(value & 0xff), true
+}
+
+// rgbCode returns the escape code that starts a RGB color sequence.
+func (c Color) rgbCode() string {
+	if c >= bgRGBStart {
+		return "48"
+	}
+	return "38"
+}
+
 // color256ToRGB converts a 256-color index to RGB values.
 // Returns (r, g, b) values in the range 0-255.
 func color256ToRGB(index int) (r, g, b int) {
@@ -10,6 +10,7 @@ import (
 
 func init() {
 	EnableColors()
+	SetColorDepthRGB(ColorDepthTrueColor)
 }
 
 func TestColor_EnableAndDisable(t *testing.T) {
@@ -400,3 +401,61 @@ func TestColor256ToRGB_Indirect(t *testing.T) {
 	css255 := Fg256Color(255).CSSClasses()
 	assert.Contains(t, css255, "fg-256-")
 }
+
+func TestColor_RGB(t *testing.T) {
+	assert.Equal(t, FgRGB(255, 136, 0), FgHex("#ff8800"))
+	assert.Equal(t, FgRGB(255, 136, 0), FgHex("f80"))
+	assert.Equal(t, BgRGB(0, 0, 0), BgHex("#000000"))
+	assert.Equal(t, Reset, FgHex("#ff88"))
+	assert.Equal(t, Reset, BgHex("orange"))
+	assert.Equal(t, Reset, FgRGB(256, 0, 0))
+	assert.Equal(t, Reset, BgRGB(0, -1, 0))
+	assert.NotEqual(t, FgRGB(0, 0, 0), BgRGB(0, 0, 0))
+
+	assert.Equal(t, "", FgRGB(255, 136, 0).CSSClasses())
+	assert.Equal(t, "color: #ff8800;", FgRGB(255, 136, 0).CSSStyle())
+	assert.Equal(t, "background-color: #0a0b0c;", BgRGB(10, 11, 12).CSSStyle())
+	assert.Equal(t, "", FgRed.CSSStyle())
+	assert.Equal(t, `style="color: #ff8800;"`, FgHex("#ff8800").HTMLProperty())
+	assert.Equal(t, `class="bold" style="background-color: #000000; color: #ffffff;"`,
+		Colors{FgHex("#fff"), Bold, BgHex("#000")}.HTMLProperty())
+	assert.Equal(t, `class="fg-red"`, Colors{FgRed}.HTMLProperty())
+	assert.Equal(t, "", Colors{}.HTMLProperty())
+}
+
+func TestColor_Sprint_RGB(t *testing.T) {
+	defer SetColorDepthRGB(ColorDepthTrueColor)
+
+	// the RGB colors are rendered as is irrespective of the ColorDepth
+	fgOrange := FgHex("#ff8800").Sprint("test")
+	colors := Colors{Bold, FgHex("#ff8800"), BgRGB(1, 2, 3)}.Sprint("test")
+	for _, depth := range []ColorDepth{ColorDepthTrueColor, ColorDepth256, ColorDepth16} {
+		SetColorDepthRGB(depth)
+		assert.Equal(t, "\x1b[38;2;255;136;0mtest\x1b[0m", FgHex("#ff8800").Sprint("test"))
+		assert.Equal(t, "\x1b[48;2;1;2;3mtest\x1b[0m", BgRGB(1, 2, 3).Sprint("test"))
+		assert.Equal(t, "\x1b[1;38;2;255;136;0;48;2;1;2;3mtest\x1b[0m",
+			Colors{Bold, FgHex("#ff8800"), BgRGB(1, 2, 3)}.Sprint("test"))
+	}
+
+	// and get degraded by DegradeRGBColors
+	SetColorDepthRGB(ColorDepthTrueColor)
+	assert.Equal(t, fgOrange, DegradeRGBColors(fgOrange))
+	assert.Equal(t, colors, DegradeRGBColors(colors))
+
+	SetColorDepthRGB(ColorDepth256)
+	assert.Equal(t, "\x1b[38;5;214mtest\x1b[0m", DegradeRGBColors(fgOrange))
+	assert.Equal(t, "\x1b[1;38;5;214;48;5;16mtest\x1b[0m", DegradeRGBColors(colors))
+
+	SetColorDepthRGB(ColorDepth16)
+	assert.Equal(t, "\x1b[93mtest\x1b[0m", DegradeRGBColors(fgOrange))
+	assert.Equal(t, "\x1b[1;93;40mtest\x1b[0m", DegradeRGBColors(colors))
+	assert.Equal(t, "a \x1b[93mb\x1b[0m c \x1b[1;40md\x1b[0m",
+		DegradeRGBColors("a "+fgOrange[:len(fgOrange)-8]+"b\x1b[0m c \x1b[1;48;2;1;2;3md\x1b[0m"))
+
+	// colors other than RGB colors are never degraded
+	SetColorDepthRGB(ColorDepthNone)
+	fg256 := Colors{Fg256Color(2), Bg256Color(214)}.Sprint("test")
+	assert.Equal(t, "\x1b[38;5;2;48;5;214mtest\x1b[0m", DegradeRGBColors(fg256))
+	assert.Equal(t, "\x1b[93mtest\x1b[0m", DegradeRGBColors(fgOrange))
+	assert.Equal(t, "\x1b[38;2;1;2mtest\x1b[0m", DegradeRGBColors("\x1b[38;2;1;2mtest\x1b[0m"))
+}
@@ -66,6 +66,7 @@ const (
 	// conceal OSI sequences
 	escapeStartConcealOSI = "\x1b]8;"
 	escapeStopConcealOSI  = "\x1b\\"
+	escapeHyperlinkClose  = escapeStartConcealOSI + ";" + escapeStopConcealOSI
 
 	// escSeqMaxLength bounds the accumulated escape sequence; real terminals
 	// cap sequences in the low KBs, and anything longer here is most likely
@@ -90,6 +91,12 @@ const (
 	escCode256Max     = 255
 )
 
+// RGB (true-color) codes
+const (
+	escCodeRGBColor = 2
+	escCodeRGBMax   = int(rgbMax) // 0xFFFFFF
+)
+
 // Internal encoding for 256-color codes uses fg256Start and bg256Start from color.go
 // Private constants initialized from private constants to avoid repeated casting in hot paths
 // Foreground 256-color: fg256Start + colorIndex (1000-1255)
@@ -99,6 +106,14 @@ const (
 	escCode256BgBase = int(bg256Start) // 2000
 )
 
+// Internal encoding for RGB codes uses fgRGBStart and bgRGBStart from color.go
+// Foreground RGB: fgRGBStart + 0xRRGGBB
+// Background RGB: bgRGBStart + 0xRRGGBB
+const (
+	escCodeRGBFgBase = int(fgRGBStart)
+	escCodeRGBBgBase = int(bgRGBStart)
+)
+
 // Standard color code ranges
 const (
 	// Standard foreground colors (30-37)
@@ -134,6 +149,9 @@ type EscSeqParser struct {
 	// escapeSeq accumulates the current escape sequence being parsed; it is
 	// bounded by escSeqMaxLength and reused across sequences.
 	escapeSeq []byte
+	// hyperlink is the OSC 8 sequence that opened the current hyperlink, if
+	// one is open.
+	hyperlink string
 }
 
 func (s *EscSeqParser) Codes() []int {
@@ -193,18 +211,24 @@ func (s *EscSeqParser) InSequence() bool {
 }
 
 func (s *EscSeqParser) IsOpen() bool {
-	return len(s.codes) > 0
+	return len(s.codes) > 0 || s.hyperlink != ""
 }
 
 func (s *EscSeqParser) ParseSeq(seq string, seqKind escSeqKind) {
 	if s.codes == nil {
 		s.codes = make(map[int]bool)
 	}
+	// OSC 8 hyperlinks (\x1b]8;params;url\x1b\\) open and close a link, and
+	// have nothing to do with the "8" (conceal) code
+	if seqKind == escSeqKindOSI && strings.HasPrefix(seq, escapeStartConcealOSI) {
+		s.parseHyperlink(seq)
+		return
+	}
 
 	seq = s.stripEscapeSequence(seq, seqKind)
 	codes := s.splitAndTrimCodes(seq)
-	processed256ColorIndices := s.process256ColorSequences(codes)
-	s.processRegularCodes(codes, processed256ColorIndices)
+	processedColorIndices := s.processExtendedColorSequences(codes)
+	s.processRegularCodes(codes, processedColorIndices)
 }
 
 func (s *EscSeqParser) ParseString(str string) string {
@@ -223,7 +247,7 @@ func (s *EscSeqParser) Reset() {
 
 func (s *EscSeqParser) Sequence() string {
 	out := strings.Builder{}
-	if s.IsOpen() {
+	if len(s.codes) > 0 {
 		out.WriteString(EscapeStart)
 		codes := s.Codes()
 		for idx, code := range codes {
@@ -238,6 +262,13 @@ func (s *EscSeqParser) Sequence() string {
 				// 256-color background code (2000-2255)
 				colorIndex := code - escCode256BgBase
 				out.WriteString(fmt.Sprintf("%d;%d;%d", escCode256BgStart, escCode256Color, colorIndex))
+			} else if r, g, b, ok := Color(code).rgb(); ok {
+				// RGB foreground/background code
+				escCodeStart := escCode256FgStart
+				if code >= escCodeRGBBgBase {
+					escCodeStart = escCode256BgStart
+				}
+				out.WriteString(fmt.Sprintf("%d;%d;%d;%d;%d", escCodeStart, escCodeRGBColor, r, g, b))
 			} else {
 				// Regular code
 				out.WriteString(fmt.Sprint(code))
@@ -245,52 +276,24 @@ func (s *EscSeqParser) Sequence() string {
 		}
 		out.WriteString(EscapeStop)
 	}
+	out.WriteString(s.hyperlink)
 
 	return out.String()
 }
 
 // clearAllBackgroundColors clears all background color codes.
 func (s *EscSeqParser) clearAllBackgroundColors() {
-	for code := escCodeBgStdStart; code <= escCodeBgStdEnd; code++ {
-		delete(s.codes, code)
-	}
-	for code := escCodeBgBrightStart; code <= escCodeBgBrightEnd; code++ {
-		delete(s.codes, code)
-	}
-	for code := escCode256BgBase; code <= escCode256BgBase+escCode256Max; code++ {
-		delete(s.codes, code)
+	for code := range s.codes {
+		if isBackgroundColorCode(code) {
+			delete(s.codes, code)
+		}
 	}
 }
 
 // clearAllForegroundColors clears all foreground color codes.
 func (s *EscSeqParser) clearAllForegroundColors() {
-	for code := escCodeFgStdStart; code <= escCodeFgStdEnd; code++ {
-		delete(s.codes, code)
-	}
-	for code := escCodeFgBrightStart; code <= escCodeFgBrightEnd; code++ {
-		delete(s.codes, code)
-	}
-	for code := escCode256FgBase; code <= escCode256FgBase+escCode256Max; code++ {
-		delete(s.codes, code)
-	}
-}
-
-// clearColorRange clears standard foreground or background colors.
-func (s *EscSeqParser) clearColorRange(isForeground bool) {
-	if isForeground {
-		// Clear standard foreground colors (30-37, 90-97)
-		for code := escCodeFgStdStart; code <= escCodeFgStdEnd; code++ {
-			delete(s.codes, code)
-		}
-		for code := escCodeFgBrightStart; code <= escCodeFgBrightEnd; code++ {
-			delete(s.codes, code)
-		}
-	} else {
-		// Clear standard background colors (40-47, 100-107)
-		for code := escCodeBgStdStart; code <= escCodeBgStdEnd; code++ {
-			delete(s.codes, code)
-		}
-		for code := escCodeBgBrightStart; code <= escCodeBgBrightEnd; code++ {
+	for code := range s.codes {
+		if isForegroundColorCode(code) {
 			delete(s.codes, code)
 		}
 	}
@@ -308,9 +311,26 @@ func (s *EscSeqParser) isEscapeStopRune(char rune) bool {
 	return false
 }
 
-// isRegularCode checks if a code is a regular code (not a 256-color encoded value).
+// isRegularCode checks if a code is a regular code (not a 256-color/RGB
+// encoded value).
 func (s *EscSeqParser) isRegularCode(codeNum int) bool {
-	return codeNum < escCode256FgBase || codeNum > escCode256BgBase+escCode256Max
+	if codeNum >= escCode256FgBase && codeNum <= escCode256BgBase+escCode256Max {
+		return false
+	}
+	_, _, _, isRGB := Color(codeNum).rgb()
+	return !isRGB
+}
+
+// parseHyperlink tracks the hyperlink opened (with a URL) or closed (without
+// one) by the OSC 8 sequence.
+func (s *EscSeqParser) parseHyperlink(seq string) {
+	params := strings.TrimPrefix(seq, escapeStartConcealOSI)
+	params = strings.TrimSuffix(strings.TrimSuffix(params, escapeStopConcealOSI), string(escRuneBEL))
+	if idx := strings.Index(params, ";"); idx >= 0 && idx < len(params)-1 {
+		s.hyperlink = escapeStartConcealOSI + params + escapeStopConcealOSI
+	} else {
+		s.hyperlink = ""
+	}
 }
 
 // parse256ColorSequence attempts to parse a 256-color sequence starting at index i.
@@ -348,18 +368,54 @@ func (s *EscSeqParser) parse256ColorSequence(codes []string, i int) (colorIndex
 	return colorIndex, expectedBase, true
 }
 
-// process256ColorSequences processes 256-color sequences (38;5;n or 48;5;n) and returns
-// a map of indices that were part of valid 256-color sequences.
-func (s *EscSeqParser) process256ColorSequences(codes []string) map[int]bool {
+// parseRGBColorSequence attempts to parse a RGB sequence starting at index i.
+// Returns (encodedValue, isForeground, true) if valid, or (0, false, false) if not.
+func (s *EscSeqParser) parseRGBColorSequence(codes []string, i int) (code int, isForeground bool, ok bool) {
+	if i+4 >= len(codes) {
+		return 0, false, false
+	}
+
+	codeNum, err := strconv.Atoi(codes[i])
+	if err != nil || (codeNum != escCode256FgStart && codeNum != escCode256BgStart) {
+		return 0, false, false
+	}
+	nextCode, err := strconv.Atoi(codes[i+1])
+	if err != nil || nextCode != escCodeRGBColor {
+		return 0, false, false
+	}
+
+	var rgb [3]int
+	for idx := range rgb {
+		rgb[idx], err = strconv.Atoi(codes[i+2+idx])
+		if err != nil || rgb[idx] < 0 || rgb[idx] > 255 {
+			return 0, false, false
+		}
+	}
+
+	if codeNum == escCode256FgStart {
+		return int(FgRGB(rgb[0], rgb[1], rgb[2])), true, true
+	}
+	return int(BgRGB(rgb[0], rgb[1], rgb[2])), false, true
+}
+
+// processExtendedColorSequences processes 256-color sequences (38;5;n or
+// 48;5;n) and RGB sequences (38;2;r;g;b or 48;2;r;g;b), and returns a map of
+// indices that were part of valid sequences.
+func (s *EscSeqParser) processExtendedColorSequences(codes []string) map[int]bool {
 	processedIndices := make(map[int]bool)
 	for i := 0; i < len(codes); i++ {
 		if colorIndex, base, ok := s.parse256ColorSequence(codes, i); ok {
-			s.set256Color(base, colorIndex)
-			s.clearColorRange(base == escCode256FgBase)
+			s.setColor(base+colorIndex, base == escCode256FgBase)
 			processedIndices[i] = true
 			processedIndices[i+1] = true
 			processedIndices[i+2] = true
 			i += 2 // Skip i+1 and i+2 (loop will increment to i+3)
+		} else if code, isForeground, ok := s.parseRGBColorSequence(codes, i); ok {
+			s.setColor(code, isForeground)
+			for idx := i; idx <= i+4; idx++ {
+				processedIndices[idx] = true
+			}
+			i += 4 // Skip i+1 to i+4 (loop will increment to i+5)
 		}
 	}
 	return processedIndices
@@ -389,7 +445,11 @@ func (s *EscSeqParser) processCode(codeNum int) {
 	case escCodeResetBg:
 		s.clearAllBackgroundColors()
 	default:
-		if s.isRegularCode(codeNum) {
+		if isForegroundColorCode(codeNum) {
+			s.setColor(codeNum, true)
+		} else if isBackgroundColorCode(codeNum) {
+			s.setColor(codeNum, false)
+		} else if s.isRegularCode(codeNum) {
 			s.codes[codeNum] = true
 		}
 	}
@@ -411,17 +471,15 @@ func (s *EscSeqParser) processRegularCodes(codes []string, processedIndices map[
 	}
 }
 
-// set256Color sets a 256-color code and clears conflicting colors.
-func (s *EscSeqParser) set256Color(base int, colorIndex int) {
-	encodedValue := base + colorIndex
-	s.codes[encodedValue] = true
-
-	// Clear other colors in the same range
-	for code := base; code <= base+escCode256Max; code++ {
-		if code != encodedValue {
-			delete(s.codes, code)
-		}
+// setColor sets a foreground/background color code and clears conflicting
+// colors.
+func (s *EscSeqParser) setColor(code int, isForeground bool) {
+	if isForeground {
+		s.clearAllForegroundColors()
+	} else {
+		s.clearAllBackgroundColors()
 	}
+	s.codes[code] = true
 }
 
 // splitAndTrimCodes splits the sequence by semicolons and trims whitespace.
@@ -444,3 +502,21 @@ func (s *EscSeqParser) stripEscapeSequence(seq string, seqKind escSeqKind) strin
 	}
 	return seq
 }
+
+// isBackgroundColorCode returns true if the code is any of the (regular,
+// bright, 256-color or RGB) background colors.
+func isBackgroundColorCode(code int) bool {
+	return (code >= escCodeBgStdStart && code <= escCodeBgStdEnd) ||
+		(code >= escCodeBgBrightStart && code <= escCodeBgBrightEnd) ||
+		(code >= escCode256BgBase && code <= escCode256BgBase+escCode256Max) ||
+		(code >= escCodeRGBBgBase && code <= escCodeRGBBgBase+escCodeRGBMax)
+}
+
+// isForegroundColorCode returns true if the code is any of the (regular,
+// bright, 256-color or RGB) foreground colors.
+func isForegroundColorCode(code int) bool {
+	return (code >= escCodeFgStdStart && code <= escCodeFgStdEnd) ||
+		(code >= escCodeFgBrightStart && code <= escCodeFgBrightEnd) ||
+		(code >= escCode256FgBase && code <= escCode256FgBase+escCode256Max) ||
+		(code >= escCodeRGBFgBase && code <= escCodeRGBFgBase+escCodeRGBMax)
+}
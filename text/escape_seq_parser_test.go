@@ -82,17 +82,29 @@ func TestEscSeqParser(t *testing.T) {
 		for _, char := range "\x1b]8;;url\x07" {
 			es.Consume(char)
 		}
-		// After consuming BEL, the parser should have reset
-		// Note: code 8 (conceal) will be parsed before reset
+		// After consuming BEL, the parser should have reset, with the link
+		// open (and not code 8 for conceal)
 		assert.False(t, es.InSequence())
 		assert.True(t, es.IsOpen())
-		assert.Contains(t, es.Codes(), 8)
+		assert.Empty(t, es.Codes())
+		assert.Equal(t, "\x1b]8;;url\x1b\\", es.Sequence())
 
 		// Test with a full hyperlink sequence
 		es = EscSeqParser{} // fresh parser
 		result := es.ParseString("\x1b]8;;https://example.com\x07Click here\x1b]8;;\x07")
-		// Code 8 (conceal) will be parsed
-		assert.Equal(t, "\x1b[8m", result)
+		// The link is closed by the end
+		assert.Equal(t, "", result)
+		assert.False(t, es.IsOpen())
+	})
+
+	t.Run("osi hyperlink with colors", func(t *testing.T) {
+		es := EscSeqParser{}
+		result := es.ParseString("\x1b[91m\x1b]8;id=1;https://example.com\x1b\\Click")
+		assert.Equal(t, []int{91}, es.Codes())
+		assert.Equal(t, "\x1b[91m\x1b]8;id=1;https://example.com\x1b\\", result)
+
+		result = es.ParseString(" here\x1b]8;;\x1b\\")
+		assert.Equal(t, "\x1b[91m", result)
 	})
 
 	t.Run("consume directly", func(t *testing.T) {
@@ -408,6 +420,31 @@ func TestEscSeqParser(t *testing.T) {
 		assert.Contains(t, es.Codes(), escCode256FgBase+200)
 		assert.Len(t, es.Codes(), 1)
 	})
+
+	t.Run("rgb colors", func(t *testing.T) {
+		es := EscSeqParser{}
+
+		assert.Equal(t, "\x1b[1;38;2;255;136;0m", es.ParseString("\x1b[1;38;2;255;136;0mBrand"))
+		assert.Equal(t, "\x1b[1;38;2;255;136;0;48;2;0;0;0m", es.ParseString("\x1b[48;2;0;0;0m on Black"))
+		assert.Equal(t, []int{escCodeBold, escCodeRGBFgBase + 0xff8800, escCodeRGBBgBase}, es.Codes())
+
+		// other foreground colors replace the RGB color, and vice versa
+		assert.Equal(t, "\x1b[1;38;5;100;48;2;0;0;0m", es.ParseString("\x1b[38;5;100m256-color"))
+		assert.Equal(t, "\x1b[1;38;2;1;2;3;48;2;0;0;0m", es.ParseString("\x1b[38;2;1;2;3mRGB"))
+		assert.Equal(t, "\x1b[1;41;38;2;1;2;3m", es.ParseString("\x1b[41mRed"))
+		assert.Equal(t, "\x1b[1;41m", es.ParseString("\x1b[39mDefault"))
+		assert.Equal(t, "", es.ParseString("\x1b[0mReset"))
+	})
+
+	t.Run("rgb colors invalid", func(t *testing.T) {
+		es := EscSeqParser{}
+
+		es.ParseSeq("\x1b[38;2;256;0;0m", escSeqKindCSI)
+		es.ParseSeq("\x1b[48;2;0;0m", escSeqKindCSI)
+		for _, code := range es.Codes() {
+			assert.True(t, es.isRegularCode(code), code)
+		}
+	})
 }
 
 func TestEscSeqParser_ConsumeMalformedSequence(t *testing.T) {
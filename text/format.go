@@ -21,7 +21,7 @@ const (
 func (tc Format) Apply(text string) string {
 	switch tc {
 	case FormatLower:
-		return strings.ToLower(text)
+		return mapWithoutEscSeq(text, unicode.ToLower)
 	case FormatTitle:
 		return toTitle(text)
 	case FormatUpper:
@@ -32,46 +32,33 @@ func (tc Format) Apply(text string) string {
 }
 
 func toTitle(text string) string {
-	prev, inEscSeq := ' ', false
-	return strings.Map(
-		func(r rune) rune {
-			if r == EscapeStartRune {
-				inEscSeq = true
-			}
-			if !inEscSeq {
-				if isSeparator(prev) {
-					prev = r
-					r = unicode.ToUpper(r)
-				} else {
-					prev = r
-				}
-			}
-			if inEscSeq && r == EscapeStopRune {
-				inEscSeq = false
-			}
-			return r
-		},
-		text,
-	)
+	prev := ' '
+	return mapWithoutEscSeq(text, func(r rune) rune {
+		if isSeparator(prev) {
+			prev = r
+			return unicode.ToUpper(r)
+		}
+		prev = r
+		return r
+	})
 }
 
 func toUpper(text string) string {
-	inEscSeq := false
-	return strings.Map(
-		func(r rune) rune {
-			if r == EscapeStartRune {
-				inEscSeq = true
-			}
-			if !inEscSeq {
-				r = unicode.ToUpper(r)
-			}
-			if inEscSeq && r == EscapeStopRune {
-				inEscSeq = false
-			}
+	return mapWithoutEscSeq(text, unicode.ToUpper)
+}
+
+// mapWithoutEscSeq is like strings.Map, but leaves the escape sequences (and
+// the URLs in the hyperlinks) as is.
+func mapWithoutEscSeq(text string, mapping func(rune) rune) string {
+	esp := EscSeqParser{}
+	return strings.Map(func(r rune) rune {
+		inEscSeq := esp.InSequence() || r == EscapeStartRune
+		esp.Consume(r)
+		if inEscSeq {
 			return r
-		},
-		text,
-	)
+		}
+		return mapping(r)
+	}, text)
 }
 
 // isSeparator returns true if the given rune is a separator. This function is
@@ -42,4 +42,10 @@ func TestFormat_Apply(t *testing.T) {
 	assert.Equal(t, "\x1b[1ma big croc0dile; died - empty_fanged ツ \u2008.\x1b[0m", FormatLower.Apply(text))
 	assert.Equal(t, "\x1b[1mA Big Croc0dile; Died - Empty_fanged ツ \u2008.\x1b[0m", FormatTitle.Apply(text))
 	assert.Equal(t, "\x1b[1mA BIG CROC0DILE; DIED - EMPTY_FANGED ツ \u2008.\x1b[0m", FormatUpper.Apply(text))
+
+	// test with hyperlinks; the URL is left as is
+	text = Hyperlink("https://example.com/Jon_Snow", "jon Snow")
+	assert.Equal(t, "\x1b]8;;https://example.com/Jon_Snow\x1b\\jon snow\x1b]8;;\x1b\\", FormatLower.Apply(text))
+	assert.Equal(t, "\x1b]8;;https://example.com/Jon_Snow\x1b\\Jon Snow\x1b]8;;\x1b\\", FormatTitle.Apply(text))
+	assert.Equal(t, "\x1b]8;;https://example.com/Jon_Snow\x1b\\JON SNOW\x1b]8;;\x1b\\", FormatUpper.Apply(text))
 }
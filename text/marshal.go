@@ -0,0 +1,211 @@
+package text
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The names used to represent the enumerations in this package in text form
+// (JSON, YAML, config files, flags, etc.).
+var (
+	alignNames = map[Align]string{
+		AlignDefault: "default",
+		AlignLeft:    "left",
+		AlignCenter:  "center",
+		AlignJustify: "justify",
+		AlignRight:   "right",
+		AlignAuto:    "auto",
+		AlignDecimal: "decimal",
+	}
+	colorNames = func() map[Color]string {
+		rsp := map[Color]string{Reset: "reset"}
+		for color, name := range colorCSSClassMap {
+			rsp[color] = name
+		}
+		return rsp
+	}()
+	directionNames = map[Direction]string{
+		Default:     "default",
+		LeftToRight: "left-to-right",
+		RightToLeft: "right-to-left",
+	}
+	formatNames = map[Format]string{
+		FormatDefault: "default",
+		FormatLower:   "lower",
+		FormatTitle:   "title",
+		FormatUpper:   "upper",
+	}
+	vAlignNames = map[VAlign]string{
+		VAlignDefault: "default",
+		VAlignTop:     "top",
+		VAlignMiddle:  "middle",
+		VAlignBottom:  "bottom",
+	}
+)
+
+// MarshalText returns the name of the Align (ex.: "right").
+func (a Align) MarshalText() ([]byte, error) {
+	return marshalEnum(a, alignNames)
+}
+
+// UnmarshalText sets the Align from its name (ex.: "right").
+func (a *Align) UnmarshalText(b []byte) error {
+	return unmarshalEnum(a, b, alignNames, "Align")
+}
+
+// MarshalText returns the name of the Direction (ex.: "right-to-left").
+func (d Direction) MarshalText() ([]byte, error) {
+	return marshalEnum(d, directionNames)
+}
+
+// UnmarshalText sets the Direction from its name (ex.: "right-to-left").
+func (d *Direction) UnmarshalText(b []byte) error {
+	return unmarshalEnum(d, b, directionNames, "Direction")
+}
+
+// MarshalText returns the name of the Format (ex.: "upper").
+func (tc Format) MarshalText() ([]byte, error) {
+	return marshalEnum(tc, formatNames)
+}
+
+// UnmarshalText sets the Format from its name (ex.: "upper").
+func (tc *Format) UnmarshalText(b []byte) error {
+	return unmarshalEnum(tc, b, formatNames, "Format")
+}
+
+// MarshalText returns the name of the VAlign (ex.: "middle").
+func (va VAlign) MarshalText() ([]byte, error) {
+	return marshalEnum(va, vAlignNames)
+}
+
+// UnmarshalText sets the VAlign from its name (ex.: "middle").
+func (va *VAlign) UnmarshalText(b []byte) error {
+	return unmarshalEnum(va, b, vAlignNames, "VAlign")
+}
+
+// MarshalText returns the name of the Color. Named colors use the same names
+// as the CSS classes in HTML mode (ex.: "fg-hi-red"), 256-colors are written
+// as "fg-256:208" or "bg-256:208", RGB colors are written as "#ff8800" or
+// "bg-#ff8800", and any other value is written as its escape sequence code.
+func (c Color) MarshalText() ([]byte, error) {
+	if name, ok := colorNames[c]; ok {
+		return []byte(name), nil
+	}
+	if r, g, b, ok := c.rgb(); ok {
+		if c >= bgRGBStart {
+			return []byte(fmt.Sprintf("bg-#%02x%02x%02x", r, g, b)), nil
+		}
+		return []byte(fmt.Sprintf("#%02x%02x%02x", r, g, b)), nil
+	}
+	if c >= fg256Start && c < fg256Start+256 {
+		return []byte(fmt.Sprintf("fg-256:%d", c-fg256Start)), nil
+	}
+	if c >= bg256Start && c < bg256Start+256 {
+		return []byte(fmt.Sprintf("bg-256:%d", c-bg256Start)), nil
+	}
+	return []byte(strconv.Itoa(int(c))), nil
+}
+
+// UnmarshalText sets the Color from its name in any of the forms written by
+// MarshalText. RGB colors may also be written as "fg-#ff8800", or using the
+// short form "#f80".
+func (c *Color) UnmarshalText(b []byte) error {
+	color, err := ParseColor(string(b))
+	if err != nil {
+		return err
+	}
+	*c = color
+	return nil
+}
+
+// ParseColor returns the Color given its name in any of the forms accepted by
+// Color.UnmarshalText. For ex.:
+//   - ParseColor("fg-hi-red") returns FgHiRed
+//   - ParseColor("bg-256:208") returns Bg256Color(208)
+//   - ParseColor("#ff8800") returns FgRGB(255, 136, 0)
+func ParseColor(name string) (Color, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for color, colorName := range colorNames {
+		if name == colorName {
+			return color, nil
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(name, "fg-256:"), strings.HasPrefix(name, "bg-256:"):
+		index, err := strconv.Atoi(name[len("fg-256:"):])
+		if err == nil && index >= 0 && index <= 255 {
+			if name[0] == 'b' {
+				return Bg256Color(index), nil
+			}
+			return Fg256Color(index), nil
+		}
+	case strings.HasPrefix(name, "#"), strings.HasPrefix(name, "fg-#"), strings.HasPrefix(name, "bg-#"):
+		if r, g, b, ok := parseHexColor(name[strings.Index(name, "#")+1:]); ok {
+			if strings.HasPrefix(name, "bg-") {
+				return BgRGB(r, g, b), nil
+			}
+			return FgRGB(r, g, b), nil
+		}
+	default:
+		if code, err := strconv.Atoi(name); err == nil {
+			return Color(code), nil
+		}
+	}
+	return Reset, fmt.Errorf("invalid Color: %q", name)
+}
+
+func marshalEnum[T comparable](value T, names map[T]string) ([]byte, error) {
+	if name, ok := names[value]; ok {
+		return []byte(name), nil
+	}
+	return nil, fmt.Errorf("invalid value: %v", value)
+}
+
+func unmarshalEnum[T comparable](value *T, b []byte, names map[T]string, typeName string) error {
+	str := strings.ToLower(strings.TrimSpace(string(b)))
+	for enum, name := range names {
+		if str == name {
+			*value = enum
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %s: %q", typeName, string(b))
+}
+
+// parseHexColor parses a color in the "rrggbb" or "rgb" forms.
+func parseHexColor(hex string) (r, g, b int, ok bool) {
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return int(value >> 16 & 0xff), int(value >> 8 & 0xff), int(value & 0xff), true
+}
+
+// rgbToColor256 returns the index of the 256-color (from the color cube or
+// the grayscale ramp) closest to the given RGB color.
+func rgbToColor256(r, g, b int) int {
+	distance := func(index int) int {
+		cr, cg, cb := color256ToRGB(index)
+		return (cr-r)*(cr-r) + (cg-g)*(cg-g) + (cb-b)*(cb-b)
+	}
+
+	cube := 16 + ((r+25)/51)*36 + ((g+25)/51)*6 + (b+25)/51
+	gray := 232 + ((r+g+b)/3-3)/10
+	if gray < 232 {
+		gray = 232
+	} else if gray > 255 {
+		gray = 255
+	}
+	if distance(gray) < distance(cube) {
+		return gray
+	}
+	return cube
+}
@@ -0,0 +1,134 @@
+package text
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlign_MarshalText(t *testing.T) {
+	for align, name := range alignNames {
+		b, err := align.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, name, string(b))
+
+		var align2 Align
+		assert.Nil(t, align2.UnmarshalText(b))
+		assert.Equal(t, align, align2)
+	}
+
+	_, err := Align(99).MarshalText()
+	assert.NotNil(t, err)
+	var align Align
+	assert.EqualError(t, align.UnmarshalText([]byte("top")), `invalid Align: "top"`)
+}
+
+func TestColor_MarshalText(t *testing.T) {
+	tests := map[Color]string{
+		Reset:              "reset",
+		Bold:               "bold",
+		FgHiRed:            "fg-hi-red",
+		BgBlue:             "bg-blue",
+		Fg256Color(208):    "fg-256:208",
+		Bg256Color(0):      "bg-256:0",
+		Color(58):          "58",
+		Bg256Color(255):    "bg-256:255",
+		Fg256RGB(5, 0, 0):  "fg-256:196",
+		FgRGB(255, 136, 0): "#ff8800",
+		BgRGB(0, 0, 0):     "bg-#000000",
+	}
+	for color, name := range tests {
+		b, err := color.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, name, string(b))
+
+		var color2 Color
+		assert.Nil(t, color2.UnmarshalText(b))
+		assert.Equal(t, color, color2)
+	}
+}
+
+func TestColor_UnmarshalJSON(t *testing.T) {
+	var colors Colors
+	err := json.Unmarshal([]byte(`["fg-hi-red", "BG-256:208", "#ff8800", "bg-#000"]`), &colors)
+	assert.Nil(t, err)
+	assert.Equal(t, Colors{FgHiRed, Bg256Color(208), FgRGB(255, 136, 0), BgRGB(0, 0, 0)}, colors)
+
+	b, err := json.Marshal(colors)
+	assert.Nil(t, err)
+	assert.Equal(t, `["fg-hi-red","bg-256:208","#ff8800","bg-#000000"]`, string(b))
+
+	err = json.Unmarshal([]byte(`["fg-purple"]`), &colors)
+	assert.EqualError(t, err, `invalid Color: "fg-purple"`)
+}
+
+func TestDirection_MarshalText(t *testing.T) {
+	for direction, name := range directionNames {
+		b, err := direction.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, name, string(b))
+
+		var direction2 Direction
+		assert.Nil(t, direction2.UnmarshalText(b))
+		assert.Equal(t, direction, direction2)
+	}
+
+	var direction Direction
+	assert.NotNil(t, direction.UnmarshalText([]byte("up")))
+}
+
+func TestFormat_MarshalText(t *testing.T) {
+	for format, name := range formatNames {
+		b, err := format.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, name, string(b))
+
+		var format2 Format
+		assert.Nil(t, format2.UnmarshalText(b))
+		assert.Equal(t, format, format2)
+	}
+
+	var format Format
+	assert.NotNil(t, format.UnmarshalText([]byte("camel")))
+}
+
+func TestParseColor(t *testing.T) {
+	tests := map[string]Color{
+		"reset":       Reset,
+		" Fg-Red ":    FgRed,
+		"bg-hi-white": BgHiWhite,
+		"fg-256:42":   Fg256Color(42),
+		"bg-256:42":   Bg256Color(42),
+		"#ff8800":     FgRGB(255, 136, 0),
+		"fg-#ffffff":  FgRGB(255, 255, 255),
+		"bg-#808080":  BgRGB(128, 128, 128),
+		"#f00":        FgRGB(255, 0, 0),
+		"1":           Bold,
+	}
+	for name, expected := range tests {
+		color, err := ParseColor(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, expected, color, name)
+	}
+
+	for _, name := range []string{"", "red", "fg-256:256", "bg-256:x", "#ff88", "#gg8800"} {
+		_, err := ParseColor(name)
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestVAlign_MarshalText(t *testing.T) {
+	for vAlign, name := range vAlignNames {
+		b, err := vAlign.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, name, string(b))
+
+		var vAlign2 VAlign
+		assert.Nil(t, vAlign2.UnmarshalText(b))
+		assert.Equal(t, vAlign, vAlign2)
+	}
+
+	var vAlign VAlign
+	assert.NotNil(t, vAlign.UnmarshalText([]byte("left")))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -42,6 +43,72 @@ var (
 // Transformer helps format the contents of an object to the user's liking.
 type Transformer func(val interface{}) string
 
+// TransformContext contains the value being transformed along with the row it
+// belongs to, for the Transformers that need more than just the value.
+type TransformContext struct {
+	// Value is the value being transformed
+	Value interface{}
+	// Row contains all the values in the row (before any transformation)
+	Row []interface{}
+	// RowNumber is the number of the row (starting at 1)
+	RowNumber int
+	// Column is the number of the column (starting at 1)
+	Column int
+	// ColumnName is the name of the column (from the header, if any)
+	ColumnName string
+	// Columns maps the name of each column (from the header, if any) to the
+	// value in the row
+	Columns map[string]interface{}
+}
+
+// ContextTransformer is like a Transformer, but gets the TransformContext of
+// the value instead of just the value.
+type ContextTransformer func(ctx TransformContext) string
+
+// Transformer returns a Transformer that calls the ContextTransformer with a
+// TransformContext containing just the value.
+func (ct ContextTransformer) Transformer() Transformer {
+	return func(val interface{}) string {
+		return ct(TransformContext{Value: val})
+	}
+}
+
+// ChainTransformers returns a Transformer that runs the given Transformers one
+// after the other, with the first one getting the value and the rest getting
+// the output (string) of the previous one. For ex., a Transformer formatting
+// a number can be followed by one that truncates the formatted number. nil
+// Transformers are skipped.
+func ChainTransformers(transformers ...Transformer) Transformer {
+	return func(val interface{}) string {
+		rsp, transformed := val, false
+		for _, transformer := range transformers {
+			if transformer != nil {
+				rsp, transformed = transformer(rsp), true
+			}
+		}
+		if !transformed {
+			return fmt.Sprint(val)
+		}
+		return rsp.(string)
+	}
+}
+
+// ConditionalTransformer returns a Transformer that uses the Transformer
+// 'then' on the values for which 'predicate' returns true, and 'otherwise' on
+// the rest. A nil Transformer renders the value as is.
+func ConditionalTransformer(predicate func(val interface{}) bool, then Transformer, otherwise Transformer) Transformer {
+	return func(val interface{}) string {
+		transformer := otherwise
+		if predicate(val) {
+			transformer = then
+		}
+		if transformer == nil {
+			return fmt.Sprint(val)
+		}
+		return transformer(val)
+	}
+}
+
 // NewNumberTransformer returns a number Transformer that:
 //   - transforms the number as directed by 'format' (ex.: %.2f)
 //   - colors negative values Red
@@ -192,6 +259,57 @@ func NewURLTransformer(colors ...Color) Transformer {
 	}
 }
 
+// NewBytesTransformer returns a Transformer that formats a number of bytes
+// using SI units (ex.: "1.50KB") or, if binary is true, IEC units (ex.:
+// "1.46KiB") with the given number of fraction digits. Refer to FormatBytes.
+func NewBytesTransformer(binary bool, precision int) Transformer {
+	return func(val interface{}) string {
+		if magnitude, negative, ok := numberToMagnitude(val); ok {
+			return formatBytes(magnitude, negative, binary, precision)
+		}
+		return fmt.Sprint(val)
+	}
+}
+
+// NewDurationTransformer returns a Transformer that formats a time.Duration (or
+// a string parseable by time.ParseDuration) rounded to the given precision in
+// the given style (ex.: "1h2m3s" or "01:02:03"). Refer to FormatDuration.
+func NewDurationTransformer(precision time.Duration, style DurationStyle) Transformer {
+	return func(val interface{}) string {
+		if valDuration, ok := val.(time.Duration); ok {
+			return FormatDuration(valDuration, precision, style)
+		} else if valStr, ok := val.(string); ok {
+			if valDuration, err := time.ParseDuration(valStr); err == nil {
+				return FormatDuration(valDuration, precision, style)
+			}
+		}
+		return fmt.Sprint(val)
+	}
+}
+
+// NewRelativeTimeTransformer returns a Transformer that formats a timestamp (a
+// time.Time or a string in one of the layouts supported by
+// NewTimeTransformer) relative to the time returned by 'now' (ex.: "3 minutes
+// ago", "in 2 days"). If 'now' is nil, time.Now is used.
+func NewRelativeTimeTransformer(now func() time.Time) Transformer {
+	if now == nil {
+		now = time.Now
+	}
+
+	return func(val interface{}) string {
+		if valTime, ok := val.(time.Time); ok {
+			return FormatRelativeTime(valTime, now())
+		}
+		rsp := fmt.Sprint(val)
+		for _, possibleTimeLayout := range possibleTimeLayouts {
+			if valTime, err := time.Parse(possibleTimeLayout, rsp); err == nil {
+				return FormatRelativeTime(valTime, now())
+			}
+		}
+		return rsp
+	}
+}
+
 func formatTime(t time.Time, layout string, location *time.Location) string {
 	rsp := ""
 	if t.Unix() > 0 {
@@ -214,3 +332,53 @@ func formatTimeUnix(unixTime int64, timeTransformer Transformer) string {
 	}
 	return timeTransformer(time.Unix(unixTime, 0))
 }
+
+// numberToMagnitude returns the absolute value of any of the built-in numeric
+// types as a uint64, along with its sign. The integers are converted as is
+// (and not through a float64, which would round the large ones), while the
+// floats are truncated and clamped to the range of uint64; NaN is not
+// considered to be a number.
+func numberToMagnitude(val interface{}) (uint64, bool, bool) {
+	var valInt int64
+	switch v := val.(type) {
+	case int:
+		valInt = int64(v)
+	case int8:
+		valInt = int64(v)
+	case int16:
+		valInt = int64(v)
+	case int32:
+		valInt = int64(v)
+	case int64:
+		valInt = v
+	case uint:
+		return uint64(v), false, true
+	case uint8:
+		return uint64(v), false, true
+	case uint16:
+		return uint64(v), false, true
+	case uint32:
+		return uint64(v), false, true
+	case uint64:
+		return v, false, true
+	case float32:
+		return float64ToMagnitude(float64(v))
+	case float64:
+		return float64ToMagnitude(v)
+	default:
+		return 0, false, false
+	}
+	magnitude, negative := int64ToMagnitude(valInt)
+	return magnitude, negative, true
+}
+
+func float64ToMagnitude(value float64) (uint64, bool, bool) {
+	if math.IsNaN(value) {
+		return 0, false, false
+	}
+	valueAbs := math.Trunc(math.Abs(value))
+	if valueAbs >= math.Ldexp(1, 64) {
+		return math.MaxUint64, value < 0, true
+	}
+	return uint64(valueAbs), value < 0 && valueAbs > 0, true
+}
@@ -0,0 +1,209 @@
+package text
+
+import (
+	"fmt"
+	"math"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// NumberStyle denotes how a number gets rendered by a Transformer created
+// using NewLocaleNumberTransformer.
+type NumberStyle int
+
+// NumberStyle enumerations
+const (
+	NumberStyleDecimal  NumberStyle = iota // "1,234,567.891"
+	NumberStyleCurrency                    // "$1,234,567.89" or "1.234.567,89 €"
+	NumberStylePercent                     // "12%" for 0.12
+	NumberStyleCompact                     // "1.2M" (suffixes as in FormatNumberShort)
+)
+
+// LocaleNumberOptions controls the output of a Transformer created using
+// NewLocaleNumberTransformer.
+type LocaleNumberOptions struct {
+	// Style defines how the number gets rendered; default: NumberStyleDecimal
+	Style NumberStyle
+	// Currency is the currency to use with NumberStyleCurrency; default: the
+	// currency of the region of the language.Tag (ex.: INR for "en-IN")
+	Currency currency.Unit
+	// Precision is the maximum number of fraction digits to render. When 0,
+	// the default for the Style is used: 3 for decimal, the standard for the
+	// currency (ex.: 2 for EUR, 0 for JPY), 0 for percent and 1 for compact.
+	// Use a negative value to render no fraction digits at all.
+	Precision int
+
+	// ColorsNegative defines the colors to use on negative numbers
+	ColorsNegative Colors
+	// ColorsPositive defines the colors to use on positive numbers
+	ColorsPositive Colors
+	// ColorsZero defines the colors to use on zero
+	ColorsZero Colors
+}
+
+// currencySymbolPlacement tells if the currency symbol goes after the amount
+// (ex.: "1.234,56 €" in German) as per the CLDR currency patterns, since
+// golang.org/x/text always renders it before the amount. It lists just the
+// common locales, with the regions that differ from their language (ex.:
+// "CHF 1’234.56" in de-CH); the locales not found here, even after falling
+// back to their parents, get the symbol before the amount. As with the percent
+// sign, the amount and a symbol after it are separated by a no-break space.
+var currencySymbolPlacement = map[language.Tag]bool{
+	language.MustParse("bg"): true,
+	language.MustParse("cs"): true,
+	language.MustParse("da"): true,
+	language.MustParse("de"): true,
+	language.MustParse("el"): true,
+	language.MustParse("es"): true,
+	language.MustParse("et"): true,
+	language.MustParse("fi"): true,
+	language.MustParse("fr"): true,
+	language.MustParse("hr"): true,
+	language.MustParse("hu"): true,
+	language.MustParse("it"): true,
+	language.MustParse("lt"): true,
+	language.MustParse("lv"): true,
+	language.MustParse("nb"): true,
+	language.MustParse("pl"): true,
+	language.MustParse("ro"): true,
+	language.MustParse("ru"): true,
+	language.MustParse("sk"): true,
+	language.MustParse("sl"): true,
+	language.MustParse("sv"): true,
+	language.MustParse("uk"): true,
+	// regions rendering the symbol before the amount
+	language.MustParse("de-AT"):  false,
+	language.MustParse("de-CH"):  false,
+	language.MustParse("de-LI"):  false,
+	language.MustParse("es-419"): false, // Latin America, ex.: es-MX, es-AR
+	language.MustParse("it-CH"):  false,
+	// regions rendering the symbol after the amount
+	language.MustParse("pt-PT"): true,
+}
+
+// currencySymbolAfter returns true if the currency symbol goes after the
+// amount for the given locale, looking up the locale and then its parents
+// (ex.: "es-MX", "es-419" and "es") in currencySymbolPlacement.
+func currencySymbolAfter(tag language.Tag) bool {
+	base, script, region := tag.Raw()
+	tag, _ = language.Compose(base, script, region) // drop the extensions
+	for ; !tag.IsRoot(); tag = tag.Parent() {
+		if after, ok := currencySymbolPlacement[tag]; ok {
+			return after
+		}
+	}
+	return false
+}
+
+// NewLocaleNumberTransformer returns a number Transformer that renders numbers
+// using the digit grouping and the decimal separator of the given language.
+// For ex., 1234567.891 gets rendered as:
+//   - "1,234,567.891" for language.English
+//   - "1.234.567,891" for language.German
+//   - "12,34,567.891" for "en-IN" (lakh grouping)
+//
+// and with NumberStyleCurrency, as "$1,234,567.89", "1.234.567,89 €" and
+// "₹12,34,567.89" respectively. Numbers are colored as directed by the Colors
+// in the options, and values that are not numbers are rendered as is.
+func NewLocaleNumberTransformer(tag language.Tag, opts LocaleNumberOptions) Transformer {
+	printer := message.NewPrinter(tag)
+	symbolAfter := currencySymbolAfter(tag)
+	unit := opts.Currency
+	if unit == (currency.Unit{}) {
+		unit, _ = currency.FromTag(tag)
+	}
+
+	return func(val interface{}) string {
+		valFloat, ok := numberToFloat64(val)
+		if !ok {
+			return fmt.Sprint(val)
+		}
+
+		isNegative := valFloat < 0
+		valFloat = math.Abs(valFloat)
+
+		// the sign and the colors go by the rounded value, as -0.001 renders
+		// as "0" and not "-0"
+		var rsp string
+		var rounded float64
+		switch opts.Style {
+		case NumberStyleCurrency:
+			scale, _ := currency.Standard.Rounding(unit)
+			digits := localeNumberPrecision(opts.Precision, scale)
+			amount := printer.Sprint(number.Decimal(valFloat, number.Scale(digits)))
+			symbol := printer.Sprint(currency.Symbol(unit))
+			if symbolAfter {
+				rsp = amount + "\u00a0" + symbol
+			} else if len([]rune(symbol)) > 1 {
+				rsp = symbol + "\u00a0" + amount
+			} else {
+				rsp = symbol + amount
+			}
+			rounded = roundToDigits(valFloat, digits)
+		case NumberStylePercent:
+			digits := localeNumberPrecision(opts.Precision, 0)
+			rsp = printer.Sprint(number.Percent(valFloat, number.MaxFractionDigits(digits)))
+			rounded = roundToDigits(valFloat*100, digits)
+		case NumberStyleCompact:
+			digits := localeNumberPrecision(opts.Precision, 1)
+			us, mantissa, _ := unitScaleFor(valFloat, unitScalesNumber, digits)
+			rsp = printer.Sprint(number.Decimal(mantissa, number.MaxFractionDigits(digits))) + us.suffix
+			rounded = roundToDigits(mantissa, digits)
+		default:
+			digits := localeNumberPrecision(opts.Precision, 3)
+			rsp = printer.Sprint(number.Decimal(valFloat, number.MaxFractionDigits(digits)))
+			rounded = roundToDigits(valFloat, digits)
+		}
+
+		colors := opts.ColorsZero
+		if rounded != 0 && isNegative {
+			rsp, colors = "-"+rsp, opts.ColorsNegative
+		} else if rounded != 0 {
+			colors = opts.ColorsPositive
+		}
+		return colors.Sprint(rsp)
+	}
+}
+
+func localeNumberPrecision(precision int, precisionDefault int) int {
+	if precision < 0 {
+		return 0
+	} else if precision == 0 {
+		return precisionDefault
+	}
+	return precision
+}
+
+// numberToFloat64 converts any of the built-in numeric types to a float64.
+func numberToFloat64(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,91 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+func TestNewLocaleNumberTransformer(t *testing.T) {
+	enIN := language.MustParse("en-IN")
+
+	t.Run("decimal", func(t *testing.T) {
+		assert.Equal(t, "1,234,567.891", NewLocaleNumberTransformer(language.English, LocaleNumberOptions{})(1234567.891))
+		assert.Equal(t, "1.234.567,891", NewLocaleNumberTransformer(language.German, LocaleNumberOptions{})(1234567.891))
+		assert.Equal(t, "12,34,567.891", NewLocaleNumberTransformer(enIN, LocaleNumberOptions{})(1234567.891))
+		assert.Equal(t, "-1,234.57", NewLocaleNumberTransformer(language.English, LocaleNumberOptions{Precision: 2})(-1234.567))
+		assert.Equal(t, "1,235", NewLocaleNumberTransformer(language.English, LocaleNumberOptions{Precision: -1})(1234.567))
+		assert.Equal(t, "42", NewLocaleNumberTransformer(language.English, LocaleNumberOptions{})(uint8(42)))
+	})
+
+	t.Run("currency", func(t *testing.T) {
+		opts := LocaleNumberOptions{Style: NumberStyleCurrency}
+		assert.Equal(t, "$1,234,567.89", NewLocaleNumberTransformer(language.AmericanEnglish, opts)(1234567.891))
+		assert.Equal(t, "1.234.567,89\u00a0€", NewLocaleNumberTransformer(language.MustParse("de-DE"), opts)(1234567.891))
+		assert.Equal(t, "-1.234.567,89\u00a0€", NewLocaleNumberTransformer(language.MustParse("de-DE"), opts)(-1234567.891))
+		assert.Equal(t, "CHF\u00a01’234.50", NewLocaleNumberTransformer(language.MustParse("de-CH"), opts)(1234.5))
+		assert.Equal(t, "1\u00a0234,50\u00a0CHF", NewLocaleNumberTransformer(language.MustParse("fr-CH"), opts)(1234.5))
+		assert.Equal(t, "$1,234.50", NewLocaleNumberTransformer(language.MustParse("es-MX"), opts)(1234.5))
+		assert.Equal(t, "1.234,50\u00a0€", NewLocaleNumberTransformer(language.MustParse("es-ES-u-nu-latn"), opts)(1234.5))
+		assert.Equal(t, "₹12,34,567.89", NewLocaleNumberTransformer(enIN, opts)(1234567.891))
+		assert.Equal(t, "￥1,235", NewLocaleNumberTransformer(language.MustParse("ja-JP"), opts)(1234.6))
+		assert.Equal(t, "￥0", NewLocaleNumberTransformer(language.Japanese, opts)(-0.125))
+
+		opts.Currency = currency.CHF
+		assert.Equal(t, "CHF\u00a01,234.50", NewLocaleNumberTransformer(language.AmericanEnglish, opts)(1234.5))
+		opts.Precision = -1
+		assert.Equal(t, "CHF\u00a01,235", NewLocaleNumberTransformer(language.AmericanEnglish, opts)(1234.6))
+	})
+
+	t.Run("percent", func(t *testing.T) {
+		opts := LocaleNumberOptions{Style: NumberStylePercent}
+		assert.Equal(t, "12%", NewLocaleNumberTransformer(language.English, opts)(0.1234))
+		assert.Equal(t, "-12%", NewLocaleNumberTransformer(language.English, opts)(-0.1234))
+		opts.Precision = 1
+		assert.Equal(t, "12,3\u00a0%", NewLocaleNumberTransformer(language.German, opts)(0.1234))
+	})
+
+	t.Run("compact", func(t *testing.T) {
+		opts := LocaleNumberOptions{Style: NumberStyleCompact}
+		transformer := NewLocaleNumberTransformer(language.English, opts)
+		assert.Equal(t, "999", transformer(999))
+		assert.Equal(t, "1.2K", transformer(1234))
+		assert.Equal(t, "-1.2M", transformer(-1234567))
+		assert.Equal(t, "3.5B", transformer(int64(3456789012)))
+		assert.Equal(t, "1.2T", transformer(1.2e12))
+		assert.Equal(t, "1,2K", NewLocaleNumberTransformer(language.German, opts)(1234))
+
+		// the unit is picked after rounding
+		assert.Equal(t, "1M", transformer(999999))
+		assert.Equal(t, "-1M", transformer(-999999))
+		assert.Equal(t, "999.9K", transformer(999949))
+		assert.Equal(t, "1K", transformer(999.96))
+		assert.Equal(t, "1K", NewLocaleNumberTransformer(language.German, opts)(999.96))
+		assert.Equal(t, "999.9", transformer(999.94))
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		transformer := NewLocaleNumberTransformer(language.English, LocaleNumberOptions{
+			ColorsNegative: Colors{FgRed},
+			ColorsPositive: Colors{FgGreen},
+			ColorsZero:     Colors{FgHiBlack},
+		})
+		assert.Equal(t, "\x1b[31m-1,234\x1b[0m", transformer(-1234))
+		assert.Equal(t, "\x1b[32m1,234\x1b[0m", transformer(1234))
+		assert.Equal(t, "\x1b[90m0\x1b[0m", transformer(0))
+		// the sign and the colors go by the rounded value
+		assert.Equal(t, "\x1b[90m0\x1b[0m", transformer(-0.0001))
+		assert.Equal(t, "\x1b[31m-0.001\x1b[0m", transformer(-0.001))
+
+		transformer = NewLocaleNumberTransformer(language.English, LocaleNumberOptions{})
+		assert.Equal(t, "-1,234", transformer(-1234))
+	})
+
+	t.Run("not a number", func(t *testing.T) {
+		transformer := NewLocaleNumberTransformer(language.English, LocaleNumberOptions{})
+		assert.Equal(t, "foo", transformer("foo"))
+		assert.Equal(t, "<nil>", transformer(nil))
+	})
+}
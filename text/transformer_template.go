@@ -0,0 +1,32 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// NewTemplateTransformer returns a ContextTransformer that renders the value
+// using the given text/template. The template is executed with the
+// TransformContext of the value, so it can refer to the value and to the
+// other values in the row. For ex.:
+//
+//	{{.Value | printf "%.1f"}} {{if gt .Value 90.0}}⚠{{end}}
+//	{{.Value}} of {{index .Columns "Total"}}
+//
+// If the template fails to execute on a value (ex.: comparing a string to a
+// number), the value is rendered as is.
+func NewTemplateTransformer(tmpl string) (ContextTransformer, error) {
+	t, err := template.New("transformer").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx TransformContext) string {
+		var out strings.Builder
+		if err := t.Execute(&out, ctx); err != nil {
+			return fmt.Sprint(ctx.Value)
+		}
+		return out.String()
+	}, nil
+}
@@ -0,0 +1,39 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTemplateTransformer(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		transformer, err := NewTemplateTransformer(`{{.Value | printf "%.1f"}}{{if gt .Value 90.0}} ⚠{{end}}`)
+		assert.Nil(t, err)
+		assert.Equal(t, "95.3 ⚠", transformer(TransformContext{Value: 95.26}))
+		assert.Equal(t, "42.0", transformer(TransformContext{Value: 42.0}))
+		assert.Equal(t, "95.3 ⚠", transformer.Transformer()(95.26))
+
+		// failures to execute render the value as is
+		assert.Equal(t, "foo", transformer(TransformContext{Value: "foo"}))
+	})
+
+	t.Run("context", func(t *testing.T) {
+		transformer, err := NewTemplateTransformer(`{{.Value}}/{{index .Columns "Total"}} (#{{.RowNumber}}, {{.ColumnName}})`)
+		assert.Nil(t, err)
+		assert.Equal(t, "3/4 (#2, Used)", transformer(TransformContext{
+			Value:      3,
+			Row:        []interface{}{3, 4},
+			RowNumber:  2,
+			Column:     1,
+			ColumnName: "Used",
+			Columns:    map[string]interface{}{"Used": 3, "Total": 4},
+		}))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		transformer, err := NewTemplateTransformer(`{{.Value`)
+		assert.NotNil(t, err)
+		assert.Nil(t, transformer)
+	})
+}
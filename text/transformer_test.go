@@ -250,3 +250,78 @@ func TestNewURLTransformer(t *testing.T) {
 	assert.Equal(t, Colors{FgRed, BgWhite, Bold}.Sprint(url), transformer2(url))
 	assert.Equal(t, colorsURL.Sprint(url), transformer(url))
 }
+
+func TestNewBytesTransformer(t *testing.T) {
+	transformer := NewBytesTransformer(false, 2)
+	assert.Equal(t, "1.50KB", transformer(1500))
+	assert.Equal(t, "1.50MB", transformer(uint32(1500000)))
+	assert.Equal(t, "999B", transformer(999.9))
+	assert.Equal(t, "foo", transformer("foo"))
+
+	transformer = NewBytesTransformer(true, 1)
+	assert.Equal(t, "1.5KiB", transformer(int64(1536)))
+	assert.Equal(t, "-512B", transformer(-512.9))
+	assert.Equal(t, "0B", transformer(-0.5))
+}
+
+func TestNewDurationTransformer(t *testing.T) {
+	transformer := NewDurationTransformer(time.Second, DurationStyleShort)
+	assert.Equal(t, "1h2m3s", transformer(time.Hour+2*time.Minute+3*time.Second+100*time.Millisecond))
+	assert.Equal(t, "1h2m3s", transformer("1h2m3.1s"))
+	assert.Equal(t, "foo", transformer("foo"))
+	assert.Equal(t, "42", transformer(42))
+
+	transformer = NewDurationTransformer(time.Second, DurationStyleClock)
+	assert.Equal(t, "01:02:03", transformer(time.Hour+2*time.Minute+3*time.Second))
+}
+
+func TestNewRelativeTimeTransformer(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	transformer := NewRelativeTimeTransformer(func() time.Time { return now })
+	assert.Equal(t, "3 minutes ago", transformer(now.Add(-3*time.Minute)))
+	assert.Equal(t, "in 2 days", transformer(now.Add(48*time.Hour)))
+	assert.Equal(t, "2 hours ago", transformer("2024-06-01T10:00:00Z"))
+	assert.Equal(t, "foo", transformer("foo"))
+
+	transformer = NewRelativeTimeTransformer(nil)
+	assert.Equal(t, "1 minute ago", transformer(time.Now().Add(-time.Minute-time.Second)))
+}
+
+func TestChainTransformers(t *testing.T) {
+	truncate := func(val interface{}) string {
+		return Trim(fmt.Sprint(val), 4)
+	}
+
+	transformer := ChainTransformers(NewNumberTransformer("%.3f"), nil, truncate)
+	assert.Equal(t, "\x1b[91m-3.1\x1b[0m", transformer(-3.14159))
+	assert.Equal(t, "\x1b[92m3.14\x1b[0m", transformer(3.14159))
+	assert.Equal(t, "foo", transformer("foo"))
+
+	assert.Equal(t, "42", ChainTransformers()(42))
+	assert.Equal(t, "42", ChainTransformers(nil)(42))
+}
+
+func TestConditionalTransformer(t *testing.T) {
+	isLarge := func(val interface{}) bool {
+		number, ok := val.(int)
+		return ok && number > 90
+	}
+	warn := func(val interface{}) string {
+		return fmt.Sprintf("%v!", val)
+	}
+
+	transformer := ConditionalTransformer(isLarge, warn, NewNumberTransformer("%d"))
+	assert.Equal(t, "95!", transformer(95))
+	assert.Equal(t, "\x1b[92m5\x1b[0m", transformer(5))
+
+	transformer = ConditionalTransformer(isLarge, nil, warn)
+	assert.Equal(t, "95", transformer(95))
+	assert.Equal(t, "5!", transformer(5))
+}
+
+func TestContextTransformer_Transformer(t *testing.T) {
+	ct := ContextTransformer(func(ctx TransformContext) string {
+		return fmt.Sprintf("%v/%d/%d", ctx.Value, ctx.RowNumber, len(ctx.Row))
+	})
+	assert.Equal(t, "foo/0/0", ct.Transformer()("foo"))
+}
@@ -0,0 +1,222 @@
+package text
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationStyle denotes how a time.Duration gets rendered by FormatDuration.
+type DurationStyle int
+
+// DurationStyle enumerations
+const (
+	DurationStyleShort DurationStyle = iota // "1h2m3s"
+	DurationStyleClock                      // "01:02:03"
+)
+
+// unitScale is a unit along with the value it stands for.
+type unitScale struct {
+	value  int64
+	suffix string
+}
+
+// The units used to format values, in descending order.
+var (
+	unitScalesBytesIEC = []unitScale{
+		{1 << 50, "PiB"},
+		{1 << 40, "TiB"},
+		{1 << 30, "GiB"},
+		{1 << 20, "MiB"},
+		{1 << 10, "KiB"},
+	}
+	unitScalesBytesSI = []unitScale{
+		{1000000000000000, "PB"},
+		{1000000000000, "TB"},
+		{1000000000, "GB"},
+		{1000000, "MB"},
+		{1000, "KB"},
+	}
+	unitScalesNumber = []unitScale{
+		{1000000000000000, "Q"},
+		{1000000000000, "T"},
+		{1000000000, "B"},
+		{1000000, "M"},
+		{1000, "K"},
+	}
+	unitScalesDuration = []struct {
+		value  time.Duration
+		suffix string
+	}{
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+		{time.Millisecond, "ms"},
+		{time.Microsecond, "µs"},
+		{time.Nanosecond, "ns"},
+	}
+	unitScalesRelativeTime = []struct {
+		value time.Duration
+		name  string
+	}{
+		{365 * 24 * time.Hour, "year"},
+		{30 * 24 * time.Hour, "month"},
+		{24 * time.Hour, "day"},
+		{time.Hour, "hour"},
+		{time.Minute, "minute"},
+		{time.Second, "second"},
+	}
+)
+
+// FormatBytes formats the given number of bytes using SI units (KB, MB, GB,
+// TB, PB) or, if binary is true, IEC units (KiB, MiB, GiB, TiB, PiB) with the
+// given number of fraction digits. For ex.:
+//   - FormatBytes(1500, false, 2) returns "1.50KB"
+//   - FormatBytes(1536, true, 1) returns "1.5KiB"
+//   - FormatBytes(999, false, 2) returns "999B"
+func FormatBytes(value int64, binary bool, precision int) string {
+	magnitude, negative := int64ToMagnitude(value)
+	return formatBytes(magnitude, negative, binary, precision)
+}
+
+// FormatNumberShort formats the given number using the suffixes K, M, B, T
+// and Q with the given number of fraction digits. For ex.:
+//   - FormatNumberShort(1500, 2) returns "1.50K"
+//   - FormatNumberShort(999, 2) returns "999"
+func FormatNumberShort(value int64, precision int) string {
+	magnitude, negative := int64ToMagnitude(value)
+	return formatUnits(magnitude, negative, unitScalesNumber, "", precision)
+}
+
+// FormatDuration formats the given time.Duration, rounded to the given
+// precision (ignored if not positive), in the given style. For ex.:
+//   - FormatDuration(3723*time.Second, time.Second, DurationStyleShort) returns "1h2m3s"
+//   - FormatDuration(3723*time.Second, time.Second, DurationStyleClock) returns "01:02:03"
+//   - FormatDuration(1500*time.Millisecond, time.Millisecond, DurationStyleClock) returns "00:00:01.500"
+func FormatDuration(d time.Duration, precision time.Duration, style DurationStyle) string {
+	if precision > 0 {
+		d = d.Round(precision)
+	}
+	var sign string
+	if d < 0 {
+		sign, d = "-", -d
+	}
+
+	if style == DurationStyleClock {
+		rsp := fmt.Sprintf("%s%02d:%02d:%02d", sign, d/time.Hour, d%time.Hour/time.Minute, d%time.Minute/time.Second)
+		if numDigits := durationFractionDigits(precision); numDigits > 0 {
+			fraction := fmt.Sprintf("%09d", d%time.Second)
+			rsp += "." + fraction[:numDigits]
+		}
+		return rsp
+	}
+
+	var out strings.Builder
+	out.WriteString(sign)
+	for _, us := range unitScalesDuration {
+		if d >= us.value {
+			fmt.Fprintf(&out, "%d%s", d/us.value, us.suffix)
+			d %= us.value
+		}
+	}
+	if out.Len() == len(sign) {
+		out.WriteString("0s")
+	}
+	return out.String()
+}
+
+// FormatRelativeTime formats the given time.Time relative to now in words.
+// For ex.: "just now", "3 minutes ago", "in 2 days", "1 year ago".
+func FormatRelativeTime(t time.Time, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	for _, us := range unitScalesRelativeTime {
+		if d >= us.value {
+			count := int64(d / us.value)
+			name := us.name
+			if count != 1 {
+				name += "s"
+			}
+			if future {
+				return fmt.Sprintf("in %d %s", count, name)
+			}
+			return fmt.Sprintf("%d %s ago", count, name)
+		}
+	}
+	return "just now"
+}
+
+// durationFractionDigits returns the number of fraction digits needed to
+// render the seconds in a duration with the given precision.
+func durationFractionDigits(precision time.Duration) int {
+	numDigits := 0
+	for unit := time.Second; precision > 0 && precision < unit && numDigits < 9; unit /= 10 {
+		numDigits++
+	}
+	return numDigits
+}
+
+func formatBytes(magnitude uint64, negative bool, binary bool, precision int) string {
+	if binary {
+		return formatUnits(magnitude, negative, unitScalesBytesIEC, "B", precision)
+	}
+	return formatUnits(magnitude, negative, unitScalesBytesSI, "B", precision)
+}
+
+// formatUnits formats the value given as its magnitude and sign, for all of
+// the int64 and the uint64 values to be covered.
+func formatUnits(magnitude uint64, negative bool, scales []unitScale, suffixNone string, precision int) string {
+	var sign string
+	if negative {
+		sign = "-"
+	}
+	us, mantissa, ok := unitScaleFor(float64(magnitude), scales, precision)
+	if !ok {
+		return fmt.Sprintf("%s%d%s", sign, magnitude, suffixNone)
+	}
+	return fmt.Sprintf("%s%.*f%s", sign, precision, mantissa, us.suffix)
+}
+
+// int64ToMagnitude returns the absolute value of the value as a uint64, along
+// with its sign.
+func int64ToMagnitude(value int64) (uint64, bool) {
+	if value < 0 {
+		// negate after converting to uint64, as -math.MinInt64 overflows
+		return -uint64(value), true
+	}
+	return uint64(value), false
+}
+
+// roundToDigits rounds the value to the given number of fraction digits, the
+// same way it gets rendered with "%.*f".
+func roundToDigits(value float64, digits int) float64 {
+	rounded, _ := strconv.ParseFloat(fmt.Sprintf("%.*f", digits, value), 64)
+	return rounded
+}
+
+// unitScaleFor returns the unit to render the (non-negative) value with, along
+// with the value in that unit, or false if it is too small for all the units.
+// The unit is picked after rounding the value to the given number of fraction
+// digits, to move up to the next unit if the rounding reached it (ex.: "1.0M"
+// instead of "1000.0K" for 999999).
+func unitScaleFor(value float64, scales []unitScale, digits int) (unitScale, float64, bool) {
+	// the scales are in descending order
+	idx := 0
+	for idx < len(scales) && value < float64(scales[idx].value) {
+		idx++
+	}
+	us, mantissa := unitScale{value: 1}, value
+	if idx < len(scales) {
+		us, mantissa = scales[idx], value/float64(scales[idx].value)
+	}
+	if idx > 0 && roundToDigits(mantissa, digits) >= float64(scales[idx-1].value)/float64(us.value) {
+		idx--
+		us, mantissa = scales[idx], value/float64(scales[idx].value)
+	}
+	return us, mantissa, idx < len(scales)
+}
@@ -0,0 +1,95 @@
+package text
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "1B", FormatBytes(1, false, 2))
+	assert.Equal(t, "999B", FormatBytes(999, false, 2))
+	assert.Equal(t, "1.50KB", FormatBytes(1500, false, 2))
+	assert.Equal(t, "1.50MB", FormatBytes(1500000, false, 2))
+	assert.Equal(t, "1.5GB", FormatBytes(1500000000, false, 1))
+	assert.Equal(t, "2TB", FormatBytes(1500000000000, false, 0))
+	assert.Equal(t, "1500.00PB", FormatBytes(1500000000000000000, false, 2))
+	assert.Equal(t, "-1.50KB", FormatBytes(-1500, false, 2))
+	assert.Equal(t, "-8192.00PiB", FormatBytes(math.MinInt64, true, 2))
+	assert.Equal(t, "8192.00PiB", FormatBytes(math.MaxInt64, true, 2))
+
+	// large values given to the transformer do not overflow, or get rounded
+	// through a float64
+	transformer := NewBytesTransformer(true, 2)
+	assert.Equal(t, "8192.00PiB", transformer(int64(math.MaxInt64)))
+	assert.Equal(t, "-8192.00PiB", transformer(int64(math.MinInt64)))
+	assert.Equal(t, "16384.00PiB", transformer(uint64(math.MaxUint64)))
+	assert.Equal(t, "16384.00PiB", transformer(1e30))
+	assert.Equal(t, "-16384.00PiB", transformer(-1e30))
+	assert.Equal(t, "NaN", transformer(math.NaN()))
+
+	assert.Equal(t, "1000B", FormatBytes(1000, true, 2))
+	assert.Equal(t, "1.50KiB", FormatBytes(1536, true, 2))
+	assert.Equal(t, "1.0MiB", FormatBytes(1<<20, true, 1))
+	assert.Equal(t, "1.50GiB", FormatBytes(3<<29, true, 2))
+	assert.Equal(t, "1TiB", FormatBytes(1<<40, true, 0))
+	assert.Equal(t, "1.00PiB", FormatBytes(1<<50, true, 2))
+	assert.Equal(t, "1.0MiB", FormatBytes(1048575, true, 1))
+	assert.Equal(t, "1023.9KiB", FormatBytes(1048500, true, 1))
+}
+
+func TestFormatNumberShort(t *testing.T) {
+	assert.Equal(t, "1", FormatNumberShort(1, 2))
+	assert.Equal(t, "1.50K", FormatNumberShort(1500, 2))
+	assert.Equal(t, "1.5M", FormatNumberShort(1500000, 1))
+	assert.Equal(t, "1.50B", FormatNumberShort(1500000000, 2))
+	assert.Equal(t, "2T", FormatNumberShort(1500000000000, 0))
+	assert.Equal(t, "1.50Q", FormatNumberShort(1500000000000000, 2))
+	assert.Equal(t, "-1.50K", FormatNumberShort(-1500, 2))
+	assert.Equal(t, "-9223.4Q", FormatNumberShort(math.MinInt64, 1))
+	assert.Equal(t, "9223.4Q", FormatNumberShort(math.MaxInt64, 1))
+	assert.Equal(t, "1.0M", FormatNumberShort(999999, 1))
+	assert.Equal(t, "999.9K", FormatNumberShort(999949, 1))
+	assert.Equal(t, "-1M", FormatNumberShort(-999500, 0))
+}
+
+func TestFormatDuration(t *testing.T) {
+	d := time.Hour + 2*time.Minute + 3*time.Second + 456789*time.Microsecond
+
+	t.Run("short", func(t *testing.T) {
+		assert.Equal(t, "1h2m3s", FormatDuration(d, time.Second, DurationStyleShort))
+		assert.Equal(t, "1h2m", FormatDuration(d, time.Minute, DurationStyleShort))
+		assert.Equal(t, "1h2m3s457ms", FormatDuration(d, time.Millisecond, DurationStyleShort))
+		assert.Equal(t, "1h2m3s456ms789µs", FormatDuration(d, 0, DurationStyleShort))
+		assert.Equal(t, "-1h2m3s", FormatDuration(-d, time.Second, DurationStyleShort))
+		assert.Equal(t, "0s", FormatDuration(0, time.Second, DurationStyleShort))
+		assert.Equal(t, "0s", FormatDuration(time.Millisecond, time.Second, DurationStyleShort))
+	})
+
+	t.Run("clock", func(t *testing.T) {
+		assert.Equal(t, "01:02:03", FormatDuration(d, time.Second, DurationStyleClock))
+		assert.Equal(t, "01:02:00", FormatDuration(d, time.Minute, DurationStyleClock))
+		assert.Equal(t, "01:02:03.5", FormatDuration(d, 100*time.Millisecond, DurationStyleClock))
+		assert.Equal(t, "01:02:03.457", FormatDuration(d, time.Millisecond, DurationStyleClock))
+		assert.Equal(t, "01:02:03.456789", FormatDuration(d, time.Microsecond, DurationStyleClock))
+		assert.Equal(t, "01:02:03", FormatDuration(d, 0, DurationStyleClock))
+		assert.Equal(t, "-01:02:03", FormatDuration(-d, time.Second, DurationStyleClock))
+		assert.Equal(t, "100:00:00", FormatDuration(100*time.Hour, time.Second, DurationStyleClock))
+	})
+}
+
+func TestFormatRelativeTime(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "just now", FormatRelativeTime(now, now))
+	assert.Equal(t, "just now", FormatRelativeTime(now.Add(-500*time.Millisecond), now))
+	assert.Equal(t, "1 second ago", FormatRelativeTime(now.Add(-time.Second), now))
+	assert.Equal(t, "3 minutes ago", FormatRelativeTime(now.Add(-3*time.Minute-10*time.Second), now))
+	assert.Equal(t, "1 hour ago", FormatRelativeTime(now.Add(-90*time.Minute), now))
+	assert.Equal(t, "in 2 days", FormatRelativeTime(now.Add(50*time.Hour), now))
+	assert.Equal(t, "2 months ago", FormatRelativeTime(now.AddDate(0, -2, 0), now))
+	assert.Equal(t, "in 1 year", FormatRelativeTime(now.AddDate(1, 0, 1), now))
+	assert.Equal(t, "5 years ago", FormatRelativeTime(now.AddDate(-5, 0, -2), now))
+}
@@ -93,7 +93,7 @@ func appendChar(char rune, wrapLen int, lineLen *int, inEscSeq bool, lastSeenEsc
 		if lastSeenEscSeq != "" {
 			// terminate escape sequence and the line; and restart the escape
 			// sequence in the next line
-			out.WriteString(EscapeReset)
+			out.WriteString(wrapEscSeqTerminator(lastSeenEscSeq))
 			out.WriteRune('\n')
 			out.WriteString(lastSeenEscSeq)
 		} else {
@@ -116,23 +116,34 @@ func appendChar(char rune, wrapLen int, lineLen *int, inEscSeq bool, lastSeenEsc
 }
 
 func appendWord(word string, lineIdx *int, lastSeenEscSeq string, wrapLen int, out *strings.Builder) {
-	inEscSeq := false
+	// hyperlinks stay open across the colors changing, and are tracked apart
+	// from them
+	hyperlink := ""
+	if idx := strings.Index(lastSeenEscSeq, escapeStartConcealOSI); idx >= 0 {
+		lastSeenEscSeq, hyperlink = lastSeenEscSeq[:idx], lastSeenEscSeq[idx:]
+	}
+
+	esp, escSeq := EscSeqParser{}, ""
 	for _, char := range word {
-		if char == EscapeStartRune {
-			inEscSeq = true
-			lastSeenEscSeq = ""
+		if char == EscapeStartRune && !esp.InSequence() {
+			escSeq = ""
 		}
-		if inEscSeq && len(lastSeenEscSeq) < escSeqMaxLength {
-			lastSeenEscSeq += string(char)
+		inEscSeq := esp.InSequence() || char == EscapeStartRune
+		if inEscSeq && len(escSeq) < escSeqMaxLength {
+			escSeq += string(char)
 		}
 
-		appendChar(char, wrapLen, lineIdx, inEscSeq, lastSeenEscSeq, out)
+		appendChar(char, wrapLen, lineIdx, inEscSeq, lastSeenEscSeq+hyperlink, out)
 
-		if inEscSeq && char == EscapeStopRune {
-			inEscSeq = false
-		}
-		if lastSeenEscSeq == EscapeReset {
-			lastSeenEscSeq = ""
+		esp.Consume(char)
+		if inEscSeq && !esp.InSequence() {
+			if strings.HasPrefix(escSeq, escapeStartConcealOSI) {
+				hyperlink = esp.hyperlink
+			} else if escSeq == EscapeReset {
+				lastSeenEscSeq = ""
+			} else {
+				lastSeenEscSeq = escSeq
+			}
 		}
 	}
 }
@@ -143,7 +154,7 @@ func terminateLine(wrapLen int, lineLen *int, lastSeenEscSeq string, out *string
 	}
 	// something is already on the line; terminate it
 	if lastSeenEscSeq != "" {
-		out.WriteString(EscapeReset)
+		out.WriteString(wrapEscSeqTerminator(lastSeenEscSeq))
 	}
 	out.WriteRune('\n')
 	out.WriteString(lastSeenEscSeq)
@@ -161,7 +172,8 @@ func wrapHard(paragraph string, wrapLen int, out *strings.Builder) {
 	lineLen, lastSeenEscSeq := 0, ""
 	words := strings.Fields(paragraph)
 	for wordIdx, word := range words {
-		if openEscSeq := esp.ParseString(word); openEscSeq != "" {
+		openEscSeq := esp.ParseString(word)
+		if openEscSeq != "" {
 			lastSeenEscSeq = openEscSeq
 		}
 		if lineLen > 0 {
@@ -177,6 +189,11 @@ func wrapHard(paragraph string, wrapLen int, out *strings.Builder) {
 			appendWord(word, &lineLen, lastSeenEscSeq, wrapLen, out)
 		}
 
+		// the hyperlink got closed by the word
+		if openEscSeq == "" && strings.Contains(lastSeenEscSeq, escapeStartConcealOSI) {
+			lastSeenEscSeq = ""
+		}
+
 		// end of line; but more words incoming
 		if lineLen == wrapLen && wordIdx < len(words)-1 {
 			terminateLine(wrapLen, &lineLen, lastSeenEscSeq, out)
@@ -190,7 +207,8 @@ func wrapSoft(paragraph string, wrapLen int, out *strings.Builder) {
 	lineLen, lastSeenEscSeq := 0, ""
 	words := strings.Fields(paragraph)
 	for wordIdx, word := range words {
-		if openEscSeq := esp.ParseString(word); openEscSeq != "" {
+		openEscSeq := esp.ParseString(word)
+		if openEscSeq != "" {
 			lastSeenEscSeq = openEscSeq
 		}
 
@@ -204,6 +222,11 @@ func wrapSoft(paragraph string, wrapLen int, out *strings.Builder) {
 			lineLen = wrapSoftLastWordInLine(wrapLen, lineLen, lastSeenEscSeq, wordLen, word, out)
 		}
 
+		// the hyperlink got closed by the word
+		if openEscSeq == "" && strings.Contains(lastSeenEscSeq, escapeStartConcealOSI) {
+			lastSeenEscSeq = ""
+		}
+
 		// end of line; but more words incoming
 		if lineLen == wrapLen && wordIdx < len(words)-1 {
 			terminateLine(wrapLen, &lineLen, lastSeenEscSeq, out)
@@ -232,3 +255,15 @@ func wrapSoftSpacing(lineLen int) (string, int) {
 	}
 	return spacing, spacingLen
 }
+
+// wrapEscSeqTerminator returns the escape sequence(s) to end a line with, to
+// terminate what the open escape sequence started (colors, hyperlinks, etc.)
+// before it gets restarted in the next line.
+func wrapEscSeqTerminator(openEscSeq string) string {
+	if !strings.Contains(openEscSeq, escapeStartConcealOSI) {
+		return EscapeReset
+	} else if strings.HasPrefix(openEscSeq, escapeStartConcealOSI) {
+		return escapeHyperlinkClose
+	}
+	return escapeHyperlinkClose + EscapeReset
+}
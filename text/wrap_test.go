@@ -173,3 +173,32 @@ func TestWrapText(t *testing.T) {
 	assert.Equal(t, expectedWide, WrapHard(textWide, 10))
 	assert.Equal(t, expectedWideColored, WrapHard(textWideColored, 10))
 }
+
+func TestWrap_RGBColors(t *testing.T) {
+	str := "\x1b[38;2;255;136;0mBrand New\x1b[0m"
+	expected := "\x1b[38;2;255;136;0mBrand\x1b[0m\n\x1b[38;2;255;136;0mNew\x1b[0m"
+	assert.Equal(t, expected, WrapSoft(str, 5))
+	assert.Equal(t, expected, WrapText(str, 5))
+	assert.Equal(t, "\x1b[38;2;255;136;0mBrand\x1b[0m\n\x1b[38;2;255;136;0mNew\x1b[0m", WrapHard(str, 5))
+}
+
+func TestWrap_Hyperlinks(t *testing.T) {
+	link := Hyperlink("https://example.com", "Brand New Day")
+	open, closeLink := "\x1b]8;;https://example.com\x1b\\", "\x1b]8;;\x1b\\"
+
+	// the link is closed at the end of every line, and re-opened in the next
+	expected := open + "Brand" + closeLink + "\n" + open + "New D" + closeLink + "\n" + open + "ay" + closeLink
+	assert.Equal(t, expected, WrapHard(link, 5))
+	assert.Equal(t, expected, WrapText(link, 5))
+	assert.Equal(t, open+"Brand"+closeLink+"\n"+open+"New  "+closeLink+"\n"+open+"Day"+closeLink, WrapSoft(link, 5))
+
+	// along with the colors
+	expected = "\x1b[31m" + open + "Brand" + closeLink + "\x1b[0m\n" +
+		"\x1b[31m" + open + "New  " + closeLink + "\x1b[0m\n" +
+		"\x1b[31m" + open + "Day" + closeLink + "\x1b[0m"
+	assert.Equal(t, expected, WrapSoft(FgRed.Sprint(link), 5))
+
+	// but not beyond the end of the link
+	expected = open + "Brand" + closeLink + "\n" + open + "New  " + closeLink + "\n" + open + "Day" + closeLink + " x\nyz"
+	assert.Equal(t, expected, WrapSoft(link+" x yz", 5))
+}